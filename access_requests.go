@@ -251,3 +251,54 @@ func (s *AccessRequestsService) DenyGroupAccessRequest(gid interface{}, user int
 
 	return s.client.Do(req, nil)
 }
+
+// PendingMemberApproval represents a pending member of a group that is
+// awaiting approval by an administrator or group owner.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-pending-members-of-a-group-and-its-subgroups-and-projects
+type PendingMemberApproval struct {
+	ID        int    `json:"id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	State     string `json:"state"`
+	AvatarURL string `json:"avatar_url"`
+	WebURL    string `json:"web_url"`
+	Approved  bool   `json:"approved"`
+	Invited   bool   `json:"invited"`
+}
+
+// ListPendingMemberApprovalsOptions represents the available
+// ListPendingMemberApprovals() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-pending-members-of-a-group-and-its-subgroups-and-projects
+type ListPendingMemberApprovalsOptions ListOptions
+
+// ListPendingMemberApprovals gets a list of the members of a group and its
+// subgroups and projects that are awaiting approval. This endpoint is only
+// available on GitLab Premium and Ultimate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-pending-members-of-a-group-and-its-subgroups-and-projects
+func (s *AccessRequestsService) ListPendingMemberApprovals(gid interface{}, opt *ListPendingMemberApprovalsOptions, options ...RequestOptionFunc) ([]*PendingMemberApproval, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/pending_members", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pms []*PendingMemberApproval
+	resp, err := s.client.Do(req, &pms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pms, resp, nil
+}
@@ -415,3 +415,72 @@ func TestDenyGroupAccessRequest(t *testing.T) {
 	assert.EqualError(t, err, "RequestOptionFunc returns an error")
 	assert.Nil(t, resp)
 }
+
+func TestListPendingMemberApprovals(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/pending_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=20")
+		fmt.Fprintf(w, `[
+			{
+			  "id": 168,
+			  "name": "Alex Garcia",
+			  "username": "alex_garcia",
+			  "email": "alex@example.com",
+			  "state": "awaiting",
+			  "avatar_url": "http://example.com/avatar.png",
+			  "web_url": "http://example.com/alex_garcia",
+			  "approved": false,
+			  "invited": false
+			},
+			{
+			  "id": 169,
+			  "email": "sidney@example.com",
+			  "state": "awaiting",
+			  "approved": false,
+			  "invited": true
+			}
+		]`)
+	})
+
+	expected := []*PendingMemberApproval{
+		{
+			ID:        168,
+			Name:      "Alex Garcia",
+			Username:  "alex_garcia",
+			Email:     "alex@example.com",
+			State:     "awaiting",
+			AvatarURL: "http://example.com/avatar.png",
+			WebURL:    "http://example.com/alex_garcia",
+		},
+		{
+			ID:      169,
+			Email:   "sidney@example.com",
+			State:   "awaiting",
+			Invited: true,
+		},
+	}
+
+	opt := &ListPendingMemberApprovalsOptions{Page: 2, PerPage: 20}
+
+	members, resp, err := client.AccessRequests.ListPendingMemberApprovals(1, opt)
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, expected, members)
+
+	members, resp, err = client.AccessRequests.ListPendingMemberApprovals(1.5, opt)
+	assert.EqualError(t, err, "invalid ID type 1.5, the ID must be an int or a string")
+	assert.Nil(t, resp)
+	assert.Nil(t, members)
+
+	members, resp, err = client.AccessRequests.ListPendingMemberApprovals(2, opt)
+	assert.Error(t, err)
+	assert.Nil(t, members)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	members, resp, err = client.AccessRequests.ListPendingMemberApprovals(1, opt, errorOption)
+	assert.EqualError(t, err, "RequestOptionFunc returns an error")
+	assert.Nil(t, resp)
+	assert.Nil(t, members)
+}
@@ -0,0 +1,139 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AnalyticsService handles communication with the analytics related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/user/analytics/
+type AnalyticsService struct {
+	client *Client
+}
+
+// AnalyticsCounts represents a time series of counts, keyed by month
+// (for example "January 2023").
+type AnalyticsCounts map[string]int
+
+// GetCodeReviewAnalyticsOptions represents the available
+// GetCodeReviewAnalytics() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/analytics/code_review_analytics.html
+type GetCodeReviewAnalyticsOptions struct {
+	MilestoneTitle *string    `url:"milestone_title,omitempty" json:"milestone_title,omitempty"`
+	LabelName      *[]string  `url:"label_name[],omitempty" json:"label_name,omitempty"`
+	CreatedAfter   *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore  *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+}
+
+// GetCodeReviewAnalytics gets the monthly number of merge requests in review
+// for a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/analytics/code_review_analytics.html
+func (s *AnalyticsService) GetCodeReviewAnalytics(pid interface{}, opt *GetCodeReviewAnalyticsOptions, options ...RequestOptionFunc) (AnalyticsCounts, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/analytics/code_review", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ac AnalyticsCounts
+	resp, err := s.client.Do(req, &ac)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ac, resp, nil
+}
+
+// GetIssuesAnalyticsOptions represents the available GetIssuesAnalytics()
+// and GetGroupIssuesAnalytics() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/analytics/issue_analytics.html
+type GetIssuesAnalyticsOptions struct {
+	Labels           *Labels    `url:"labels,omitempty" json:"labels,omitempty"`
+	MilestoneTitle   *string    `url:"milestone_title,omitempty" json:"milestone_title,omitempty"`
+	AuthorUsername   *string    `url:"author_username,omitempty" json:"author_username,omitempty"`
+	AssigneeUsername *[]string  `url:"assignee_username[],omitempty" json:"assignee_username,omitempty"`
+	MonthsBack       *int       `url:"months_back,omitempty" json:"months_back,omitempty"`
+	CreatedAfter     *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore    *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+}
+
+// GetIssuesAnalytics gets the monthly number of issues created in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/analytics/issue_analytics.html
+func (s *AnalyticsService) GetIssuesAnalytics(pid interface{}, opt *GetIssuesAnalyticsOptions, options ...RequestOptionFunc) (AnalyticsCounts, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues_analytics", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ac AnalyticsCounts
+	resp, err := s.client.Do(req, &ac)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ac, resp, nil
+}
+
+// GetGroupIssuesAnalytics gets the monthly number of issues created in a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/analytics/issue_analytics.html
+func (s *AnalyticsService) GetGroupIssuesAnalytics(gid interface{}, opt *GetIssuesAnalyticsOptions, options ...RequestOptionFunc) (AnalyticsCounts, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/issues_analytics", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ac AnalyticsCounts
+	resp, err := s.client.Do(req, &ac)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ac, resp, nil
+}
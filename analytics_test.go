@@ -0,0 +1,104 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService_GetCodeReviewAnalytics(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/analytics/code_review", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "label_name%5B%5D=backend&milestone_title=v1.0")
+		fmt.Fprint(w, `{"January 2023": 4, "February 2023": 7}`)
+	})
+
+	opt := &GetCodeReviewAnalyticsOptions{
+		MilestoneTitle: String("v1.0"),
+		LabelName:      &[]string{"backend"},
+	}
+
+	want := AnalyticsCounts{"January 2023": 4, "February 2023": 7}
+
+	ac, resp, err := client.Analytics.GetCodeReviewAnalytics(1, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, ac)
+
+	ac, resp, err = client.Analytics.GetCodeReviewAnalytics(1.01, opt)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, ac)
+
+	ac, resp, err = client.Analytics.GetCodeReviewAnalytics(1, opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, ac)
+
+	ac, resp, err = client.Analytics.GetCodeReviewAnalytics(2, opt)
+	require.Error(t, err)
+	require.Nil(t, ac)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAnalyticsService_GetIssuesAnalytics(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues_analytics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "months_back=2")
+		fmt.Fprint(w, `{"January 2023": 10, "February 2023": 3}`)
+	})
+
+	want := AnalyticsCounts{"January 2023": 10, "February 2023": 3}
+
+	ac, resp, err := client.Analytics.GetIssuesAnalytics(1, &GetIssuesAnalyticsOptions{MonthsBack: Int(2)})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, ac)
+
+	ac, resp, err = client.Analytics.GetIssuesAnalytics(2, nil)
+	require.Error(t, err)
+	require.Nil(t, ac)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAnalyticsService_GetGroupIssuesAnalytics(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/issues_analytics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "labels=bug")
+		fmt.Fprint(w, `{"March 2023": 5}`)
+	})
+
+	ac, resp, err := client.Analytics.GetGroupIssuesAnalytics(1, &GetIssuesAnalyticsOptions{Labels: &Labels{"bug"}})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, AnalyticsCounts{"March 2023": 5}, ac)
+
+	ac, resp, err = client.Analytics.GetGroupIssuesAnalytics(1.01, nil)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, ac)
+}
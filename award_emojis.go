@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -87,6 +88,46 @@ func (s *AwardEmojiService) ListSnippetAwardEmoji(pid interface{}, snippetID int
 	return s.listAwardEmoji(pid, awardSnippets, snippetID, opt, options...)
 }
 
+// ListMergeRequestAwardEmojiByName gets all award emoji with the given name
+// on the merge request. The award emoji API has no filter on the name, so
+// this walks all pages and filters the results on the client side.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#list-an-awardables-award-emojis
+func (s *AwardEmojiService) ListMergeRequestAwardEmojiByName(pid interface{}, mergeRequestIID int, name string, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
+	opt := &ListAwardEmojiOptions{PerPage: 100}
+
+	var awards []*AwardEmoji
+	for {
+		as, resp, err := s.ListMergeRequestAwardEmoji(pid, mergeRequestIID, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, a := range as {
+			if a.Name == name {
+				awards = append(awards, a)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return awards, resp, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// HasAwardFrom reports whether awards contains an award emoji with the given
+// name that was awarded by the user with the given ID.
+func HasAwardFrom(awards []*AwardEmoji, name string, userID int) bool {
+	for _, a := range awards {
+		if a.Name == name && a.User.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *AwardEmojiService) listAwardEmoji(pid interface{}, resource string, resourceID int, opt *ListAwardEmojiOptions, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
@@ -420,6 +461,61 @@ func (s *AwardEmojiService) createAwardEmojiOnNote(pid interface{}, resource str
 	return a, resp, nil
 }
 
+// maxConcurrentAwardRequests limits the number of requests CreateAwardsOnNotes
+// sends at the same time.
+const maxConcurrentAwardRequests = 5
+
+// AwardEmojiOnNoteResult represents the result of awarding an emoji to a
+// single note with CreateAwardsOnNotes.
+type AwardEmojiOnNoteResult struct {
+	NoteID     int
+	AwardEmoji *AwardEmoji
+	Response   *Response
+	Err        error
+}
+
+// CreateAwardsOnNotes awards the same emoji to multiple notes of a merge
+// request. The requests are sent concurrently, with at most
+// maxConcurrentAwardRequests in flight at any time. A result is returned for
+// every note, in the same order as noteIDs; failures for individual notes are
+// reported in the Err field of their result.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#award-a-new-emoji-on-a-comment
+func (s *AwardEmojiService) CreateAwardsOnNotes(pid interface{}, mergeRequestIID int, noteIDs []int, name string, options ...RequestOptionFunc) ([]*AwardEmojiOnNoteResult, error) {
+	if _, err := parseID(pid); err != nil {
+		return nil, err
+	}
+
+	opt := &CreateAwardEmojiOptions{Name: name}
+	results := make([]*AwardEmojiOnNoteResult, len(noteIDs))
+	sem := make(chan struct{}, maxConcurrentAwardRequests)
+
+	var wg sync.WaitGroup
+	for i, noteID := range noteIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i, noteID int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			a, resp, err := s.CreateMergeRequestAwardEmojiOnNote(pid, mergeRequestIID, noteID, opt, options...)
+			results[i] = &AwardEmojiOnNoteResult{
+				NoteID:     noteID,
+				AwardEmoji: a,
+				Response:   resp,
+				Err:        err,
+			}
+		}(i, noteID)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
 // DeleteIssuesAwardEmojiOnNote deletes an award emoji on a note from an issue.
 //
 // GitLab API docs:
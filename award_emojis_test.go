@@ -78,6 +78,62 @@ func TestAwardEmojiService_ListMergeRequestAwardEmoji(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestAwardEmojiService_ListMergeRequestAwardEmojiByName(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/80/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			testParams(t, r, "per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "name": "thumbsup", "user": {"id": 1}}, {"id": 2, "name": "tada", "user": {"id": 2}}]`)
+		case "2":
+			testParams(t, r, "page=2&per_page=100")
+			fmt.Fprint(w, `[{"id": 3, "name": "thumbsup", "user": {"id": 3}}]`)
+		default:
+			t.Fatalf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	awards, resp, err := client.AwardEmoji.ListMergeRequestAwardEmojiByName(1, 80, "thumbsup")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, awards, 2)
+	require.Equal(t, 1, awards[0].ID)
+	require.Equal(t, 3, awards[1].ID)
+
+	awards, resp, err = client.AwardEmoji.ListMergeRequestAwardEmojiByName(1.01, 80, "thumbsup")
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, awards)
+
+	awards, resp, err = client.AwardEmoji.ListMergeRequestAwardEmojiByName(1, 80, "thumbsup", errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, awards)
+
+	awards, resp, err = client.AwardEmoji.ListMergeRequestAwardEmojiByName(3, 80, "thumbsup")
+	require.Error(t, err)
+	require.Nil(t, awards)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHasAwardFrom(t *testing.T) {
+	thumbsUp := &AwardEmoji{Name: "thumbsup"}
+	thumbsUp.User.ID = 1
+	tada := &AwardEmoji{Name: "tada"}
+	tada.User.ID = 2
+
+	awards := []*AwardEmoji{thumbsUp, tada}
+
+	require.True(t, HasAwardFrom(awards, "thumbsup", 1))
+	require.True(t, HasAwardFrom(awards, "tada", 2))
+	require.False(t, HasAwardFrom(awards, "thumbsup", 2))
+	require.False(t, HasAwardFrom(awards, "thumbsdown", 1))
+	require.False(t, HasAwardFrom(nil, "thumbsup", 1))
+}
+
 func TestAwardEmojiService_ListIssueAwardEmoji(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1387,3 +1443,36 @@ func TestAwardEmojiService_DeleteSnippetAwardEmojiOnNote(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestAwardEmojiService_CreateAwardsOnNotes(t *testing.T) {
+	mux, client := setup(t)
+
+	for _, noteID := range []int{1, 2, 3} {
+		noteID := noteID
+		mux.HandleFunc(fmt.Sprintf("/api/v4/projects/1/merge_requests/80/notes/%d/award_emoji", noteID), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, `{"name":"thumbsup"}`)
+			fmt.Fprintf(w, `{"id": %d, "name": "thumbsup", "awardable_id": %d, "awardable_type": "Note"}`, noteID+10, noteID)
+		})
+	}
+
+	results, err := client.AwardEmoji.CreateAwardsOnNotes(1, 80, []int{1, 2, 3, 4}, "thumbsup")
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	for i, noteID := range []int{1, 2, 3} {
+		require.Equal(t, noteID, results[i].NoteID)
+		require.NoError(t, results[i].Err)
+		require.NotNil(t, results[i].Response)
+		require.Equal(t, &AwardEmoji{ID: noteID + 10, Name: "thumbsup", AwardableID: noteID, AwardableType: "Note"}, results[i].AwardEmoji)
+	}
+
+	require.Equal(t, 4, results[3].NoteID)
+	require.Error(t, results[3].Err)
+	require.Nil(t, results[3].AwardEmoji)
+	require.Equal(t, http.StatusNotFound, results[3].Response.StatusCode)
+
+	results, err = client.AwardEmoji.CreateAwardsOnNotes(1.01, 80, []int{1}, "thumbsup")
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, results)
+}
@@ -0,0 +1,234 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BulkImportsService handles communication with GitLab's direct transfer
+// (group and project migration by direct transfer) API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportsService struct {
+	client *Client
+}
+
+// BulkImport represents a GitLab group or project migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImport struct {
+	ID          int        `json:"id"`
+	Status      string     `json:"status"`
+	SourceType  string     `json:"source_type"`
+	SourceURL   string     `json:"source_url"`
+	HasFailures bool       `json:"has_failures"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+func (b BulkImport) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntity represents a single group or project of a migration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportEntity struct {
+	ID                   int                        `json:"id"`
+	BulkImportID         int                        `json:"bulk_import_id"`
+	Status               string                     `json:"status"`
+	EntityType           string                     `json:"entity_type"`
+	SourceFullPath       string                     `json:"source_full_path"`
+	DestinationFullPath  string                     `json:"destination_full_path"`
+	DestinationName      string                     `json:"destination_name"`
+	DestinationSlug      string                     `json:"destination_slug"`
+	DestinationNamespace string                     `json:"destination_namespace"`
+	ParentID             int                        `json:"parent_id"`
+	NamespaceID          int                        `json:"namespace_id"`
+	ProjectID            int                        `json:"project_id"`
+	MigrateProjects      bool                       `json:"migrate_projects"`
+	HasFailures          bool                       `json:"has_failures"`
+	Failures             []*BulkImportEntityFailure `json:"failures"`
+	CreatedAt            *time.Time                 `json:"created_at"`
+	UpdatedAt            *time.Time                 `json:"updated_at"`
+}
+
+func (b BulkImportEntity) String() string {
+	return Stringify(b)
+}
+
+// BulkImportEntityFailure represents a failure that occurred while migrating
+// an entity. The ExceptionMessage holds the reason of the failure.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/bulk_imports.html
+type BulkImportEntityFailure struct {
+	Relation           string     `json:"relation"`
+	Step               string     `json:"step"`
+	ExceptionMessage   string     `json:"exception_message"`
+	ExceptionClass     string     `json:"exception_class"`
+	CorrelationIDValue string     `json:"correlation_id_value"`
+	PipelineClass      string     `json:"pipeline_class"`
+	PipelineStep       string     `json:"pipeline_step"`
+	CreatedAt          *time.Time `json:"created_at"`
+}
+
+// BulkImportConfigurationOptions represents the source GitLab instance of a
+// migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportConfigurationOptions struct {
+	URL         *string `url:"url,omitempty" json:"url,omitempty"`
+	AccessToken *string `url:"access_token,omitempty" json:"access_token,omitempty"`
+}
+
+// BulkImportEntityOptions represents a single group or project to migrate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type BulkImportEntityOptions struct {
+	SourceType           *string `url:"source_type,omitempty" json:"source_type,omitempty"`
+	SourceFullPath       *string `url:"source_full_path,omitempty" json:"source_full_path,omitempty"`
+	DestinationSlug      *string `url:"destination_slug,omitempty" json:"destination_slug,omitempty"`
+	DestinationNamespace *string `url:"destination_namespace,omitempty" json:"destination_namespace,omitempty"`
+	MigrateProjects      *bool   `url:"migrate_projects,omitempty" json:"migrate_projects,omitempty"`
+}
+
+// StartBulkImportOptions represents the available StartBulkImport() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+type StartBulkImportOptions struct {
+	Configuration *BulkImportConfigurationOptions `url:"configuration,omitempty" json:"configuration,omitempty"`
+	Entities      []BulkImportEntityOptions       `url:"entities,omitempty" json:"entities,omitempty"`
+}
+
+// StartBulkImport starts a new group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#start-a-new-group-or-project-migration
+func (s *BulkImportsService) StartBulkImport(opt *StartBulkImportOptions, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// ListBulkImportsOptions represents the available ListBulkImports() and
+// ListBulkImportEntities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+type ListBulkImportsOptions struct {
+	ListOptions
+	Sort   *string `url:"sort,omitempty" json:"sort,omitempty"`
+	Status *string `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListBulkImports lists all group or project migrations.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-all-group-or-project-migrations
+func (s *BulkImportsService) ListBulkImports(opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImport, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "bulk_imports", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bs []*BulkImport
+	resp, err := s.client.Do(req, &bs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bs, resp, nil
+}
+
+// GetBulkImport gets the details of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-details
+func (s *BulkImportsService) GetBulkImport(id int, options ...RequestOptionFunc) (*BulkImport, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(BulkImport)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// ListBulkImportEntities lists the entities of a group or project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#list-group-or-project-migration-entities
+func (s *BulkImportsService) ListBulkImportEntities(id int, opt *ListBulkImportsOptions, options ...RequestOptionFunc) ([]*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities", id)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var es []*BulkImportEntity
+	resp, err := s.client.Do(req, &es)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return es, resp, nil
+}
+
+// GetBulkImportEntity gets the details of a single entity of a group or
+// project migration.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/bulk_imports.html#get-group-or-project-migration-entity-details
+func (s *BulkImportsService) GetBulkImportEntity(id, entity int, options ...RequestOptionFunc) (*BulkImportEntity, *Response, error) {
+	u := fmt.Sprintf("bulk_imports/%d/entities/%d", id, entity)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e := new(BulkImportEntity)
+	resp, err := s.client.Do(req, e)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e, resp, nil
+}
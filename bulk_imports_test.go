@@ -0,0 +1,185 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkImportsService_StartBulkImport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"configuration":{"url":"https://source.example.com","access_token":"token"},"entities":[{"source_type":"group_entity","source_full_path":"source/group","destination_slug":"group","destination_namespace":"destination","migrate_projects":true}]}`)
+		fmt.Fprint(w, `{"id": 1337, "status": "created", "source_type": "gitlab", "created_at": "2021-06-18T09:45:55.358Z", "has_failures": false}`)
+	})
+
+	createdAt := time.Date(2021, time.June, 18, 9, 45, 55, 358000000, time.UTC)
+	want := &BulkImport{
+		ID:         1337,
+		Status:     "created",
+		SourceType: "gitlab",
+		CreatedAt:  &createdAt,
+	}
+
+	opt := &StartBulkImportOptions{
+		Configuration: &BulkImportConfigurationOptions{
+			URL:         String("https://source.example.com"),
+			AccessToken: String("token"),
+		},
+		Entities: []BulkImportEntityOptions{{
+			SourceType:           String("group_entity"),
+			SourceFullPath:       String("source/group"),
+			DestinationSlug:      String("group"),
+			DestinationNamespace: String("destination"),
+			MigrateProjects:      Bool(true),
+		}},
+	}
+
+	b, resp, err := client.BulkImports.StartBulkImport(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, b)
+
+	b, resp, err = client.BulkImports.StartBulkImport(opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, b)
+}
+
+func TestBulkImportsService_ListBulkImports(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=1&per_page=2&status=finished")
+		fmt.Fprint(w, `[{"id": 1, "status": "finished", "source_type": "gitlab"}, {"id": 2, "status": "finished", "source_type": "gitlab"}]`)
+	})
+
+	want := []*BulkImport{
+		{ID: 1, Status: "finished", SourceType: "gitlab"},
+		{ID: 2, Status: "finished", SourceType: "gitlab"},
+	}
+
+	opt := &ListBulkImportsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+		Status:      String("finished"),
+	}
+
+	bs, resp, err := client.BulkImports.ListBulkImports(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, bs)
+}
+
+func TestBulkImportsService_GetBulkImport(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "status": "started", "source_type": "gitlab", "has_failures": true}`)
+	})
+
+	b, resp, err := client.BulkImports.GetBulkImport(1)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, &BulkImport{ID: 1, Status: "started", SourceType: "gitlab", HasFailures: true}, b)
+
+	b, resp, err = client.BulkImports.GetBulkImport(2)
+	require.Error(t, err)
+	require.Nil(t, b)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBulkImportsService_ListBulkImportEntities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id": 1, "bulk_import_id": 1, "status": "finished", "entity_type": "group", "source_full_path": "source/group"}]`)
+	})
+
+	want := []*BulkImportEntity{{
+		ID:             1,
+		BulkImportID:   1,
+		Status:         "finished",
+		EntityType:     "group",
+		SourceFullPath: "source/group",
+	}}
+
+	es, resp, err := client.BulkImports.ListBulkImportEntities(1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, es)
+}
+
+func TestBulkImportsService_GetBulkImportEntity(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/bulk_imports/1/entities/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+			{
+			  "id": 2,
+			  "bulk_import_id": 1,
+			  "status": "failed",
+			  "entity_type": "project",
+			  "has_failures": true,
+			  "failures": [
+			    {
+			      "relation": "issues",
+			      "step": "extractor",
+			      "exception_message": "Error!",
+			      "exception_class": "StandardError",
+			      "correlation_id_value": "06289e4b064329a69de7bb2d7a1b5a97"
+			    }
+			  ]
+			}
+		`)
+	})
+
+	want := &BulkImportEntity{
+		ID:           2,
+		BulkImportID: 1,
+		Status:       "failed",
+		EntityType:   "project",
+		HasFailures:  true,
+		Failures: []*BulkImportEntityFailure{{
+			Relation:           "issues",
+			Step:               "extractor",
+			ExceptionMessage:   "Error!",
+			ExceptionClass:     "StandardError",
+			CorrelationIDValue: "06289e4b064329a69de7bb2d7a1b5a97",
+		}},
+	}
+
+	e, resp, err := client.BulkImports.GetBulkImportEntity(1, 2)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, e)
+
+	e, resp, err = client.BulkImports.GetBulkImportEntity(1, 2, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, e)
+}
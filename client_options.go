@@ -17,13 +17,29 @@
 package gitlab
 
 import (
+	"crypto/tls"
+	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
 // ClientOptionFunc can be used to customize a new GitLab API client.
+//
+// Client options are applied in the order they are given. A request made by
+// the client passes through the following layers, from the outside in:
+//
+//  1. Client.Do, which waits for the rate limiter and adds the authentication
+//     headers for the configured AuthType.
+//  2. The retryablehttp client, which calls the request and response log
+//     hooks and retries failed requests.
+//  3. The http.Client, as configured with WithHTTPClient.
+//  4. The transport, as configured with WithTransport and WithTLSConfig.
+//
+// As authentication happens in the outermost layer, custom transports
+// always receive fully authenticated requests, once for every retry.
 type ClientOptionFunc func(*Client) error
 
 // WithBaseURL sets the base URL for API requests to a custom endpoint.
@@ -67,7 +83,13 @@ func WithCustomLogger(logger retryablehttp.Logger) ClientOptionFunc {
 	}
 }
 
-// WithCustomRetry can be used to configure a custom retry policy.
+// WithCustomRetry can be used to configure a custom retry policy. By default
+// all requests, including POST requests, are retried when GitLab responds
+// with 429 Too Many Requests or a 5xx status code, waiting as long as the
+// RateLimit-Reset or Retry-After headers ask for. The request body is rewound
+// before each attempt and retrying stops as soon as the request context is
+// done. Use WithRetryableCheck to restrict the default policy, for example to
+// only retry idempotent requests, instead of replacing it.
 func WithCustomRetry(checkRetry retryablehttp.CheckRetry) ClientOptionFunc {
 	return func(c *Client) error {
 		c.client.CheckRetry = checkRetry
@@ -75,7 +97,20 @@ func WithCustomRetry(checkRetry retryablehttp.CheckRetry) ClientOptionFunc {
 	}
 }
 
-// WithCustomRetryMax can be used to configure a custom maximum number of retries.
+// WithRetryableCheck can be used to restrict the default retry policy. The
+// check is called for every response the client would retry, after its own
+// 429 and 5xx checks, and the response is only retried when the check
+// returns true. This can, for example, be used to only retry POST requests
+// that are safe to repeat.
+func WithRetryableCheck(check func(*Response, error) bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.retryableCheck = check
+		return nil
+	}
+}
+
+// WithCustomRetryMax can be used to configure a custom maximum number of
+// retries. The default is 5.
 func WithCustomRetryMax(retryMax int) ClientOptionFunc {
 	return func(c *Client) error {
 		c.client.RetryMax = retryMax
@@ -101,7 +136,9 @@ func WithErrorHandler(handler retryablehttp.ErrorHandler) ClientOptionFunc {
 	}
 }
 
-// WithHTTPClient can be used to configure a custom HTTP client.
+// WithHTTPClient can be used to configure a custom HTTP client. Its transport
+// and timeouts are used for every request, while the authentication and
+// other headers are still set by the client for each request.
 func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	return func(c *Client) error {
 		c.client.HTTPClient = httpClient
@@ -109,6 +146,52 @@ func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	}
 }
 
+// WithProxy can be used to configure an explicit proxy for all requests,
+// instead of the proxy taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables. Like WithTLSConfig, it requires the transport to be
+// an *http.Transport, so it can be combined with WithTransport as long as
+// the custom transport is an *http.Transport that is passed first.
+func WithProxy(proxyURL string) ClientOptionFunc {
+	return func(c *Client) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return err
+		}
+		return c.configureTransport("WithProxy", func(t *http.Transport) {
+			t.Proxy = http.ProxyURL(u)
+		})
+	}
+}
+
+// WithTLSConfig can be used to configure the TLS settings of the transport,
+// for example to trust a private CA or to use client certificates (mTLS).
+// It requires the transport to be an *http.Transport, which is the case
+// unless a custom transport is configured. When using WithHTTPClient, it
+// must be passed before WithTLSConfig. When using a custom transport that
+// is not an *http.Transport, configure TLS on that transport instead.
+func WithTLSConfig(config *tls.Config) ClientOptionFunc {
+	return func(c *Client) error {
+		return c.configureTransport("WithTLSConfig", func(t *http.Transport) {
+			t.TLSClientConfig = config
+		})
+	}
+}
+
+// WithTransport can be used to configure a custom transport, for example
+// to add tracing or to route requests through a custom dialer. The transport
+// replaces the transport of the HTTP client, so it overrides any previous
+// WithTLSConfig or WithProxy option. When using WithHTTPClient, it must be passed before
+// WithTransport. Retries, logging and authentication are not part of the
+// transport, so they keep working as usual.
+func WithTransport(transport http.RoundTripper) ClientOptionFunc {
+	return func(c *Client) error {
+		hc := *c.client.HTTPClient
+		hc.Transport = transport
+		c.client.HTTPClient = &hc
+		return nil
+	}
+}
+
 // WithRequestLogHook can be used to configure a custom request log hook.
 func WithRequestLogHook(hook retryablehttp.RequestLogHook) ClientOptionFunc {
 	return func(c *Client) error {
@@ -117,6 +200,16 @@ func WithRequestLogHook(hook retryablehttp.RequestLogHook) ClientOptionFunc {
 	}
 }
 
+// WithRequestLogger can be used to configure a logger that is called after
+// every round trip, also when the request failed. Credential headers like
+// Authorization are redacted before the request is passed to the logger.
+func WithRequestLogger(logger RequestLogger) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestLogger = logger
+		return nil
+	}
+}
+
 // WithResponseLogHook can be used to configure a custom response log hook.
 func WithResponseLogHook(hook retryablehttp.ResponseLogHook) ClientOptionFunc {
 	return func(c *Client) error {
@@ -125,6 +218,17 @@ func WithResponseLogHook(hook retryablehttp.ResponseLogHook) ClientOptionFunc {
 	}
 }
 
+// WithTracer can be used to configure a tracer that creates a span for every
+// request. Spans are tagged with the request method, the path template of
+// the request and the response status code, and are also ended when the
+// request failed without a response.
+func WithTracer(tracer Tracer) ClientOptionFunc {
+	return func(c *Client) error {
+		c.tracer = tracer
+		return nil
+	}
+}
+
 // WithoutRetries disables the default retry logic.
 func WithoutRetries() ClientOptionFunc {
 	return func(c *Client) error {
@@ -140,3 +244,26 @@ func WithRequestOptions(options ...RequestOptionFunc) ClientOptionFunc {
 		return nil
 	}
 }
+
+// configureTransport applies fn to a copy of the transport of the HTTP client,
+// so neither the default transport nor a transport that is shared with other
+// clients is modified.
+func (c *Client) configureTransport(option string, fn func(*http.Transport)) error {
+	hc := *c.client.HTTPClient
+
+	var transport *http.Transport
+	switch t := hc.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return fmt.Errorf("%s requires an *http.Transport, got %T", option, hc.Transport)
+	}
+
+	fn(transport)
+	hc.Transport = transport
+	c.client.HTTPClient = &hc
+
+	return nil
+}
@@ -0,0 +1,446 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client, err := NewClient("token",
+		WithBaseURL(server.URL),
+		WithTLSConfig(&tls.Config{RootCAs: pool}),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "test", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+
+	// Make sure the default transport was not modified.
+	if cfg := http.DefaultTransport.(*http.Transport).TLSClientConfig; cfg != nil {
+		require.Nil(t, cfg.RootCAs)
+	}
+}
+
+func TestWithTLSConfigCustomTransport(t *testing.T) {
+	_, err := NewClient("",
+		WithTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, nil
+		})),
+		WithTLSConfig(&tls.Config{}),
+	)
+	require.EqualError(t, err, "WithTLSConfig requires an *http.Transport, got gitlab.roundTripperFunc")
+}
+
+func TestWithTransport(t *testing.T) {
+	var attempts int
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		require.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+
+		status := http.StatusOK
+		if attempts == 1 {
+			status = http.StatusServiceUnavailable
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    r,
+		}, nil
+	})
+
+	var logged int
+	httpClient := &http.Client{}
+
+	client, err := NewClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithHTTPClient(httpClient),
+		WithTransport(transport),
+		WithRequestLogHook(func(_ retryablehttp.Logger, _ *http.Request, _ int) { logged++ }),
+		WithCustomRetryWaitMinMax(0, 0),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "test", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, 2, logged)
+
+	// Make sure the given HTTP client was not modified.
+	require.Nil(t, httpClient.Transport)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	var recorded *http.Request
+
+	httpClient := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			recorded = r
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		}),
+	}
+
+	client, err := NewClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithHTTPClient(httpClient),
+	)
+	require.NoError(t, err)
+	require.Same(t, httpClient, client.client.HTTPClient)
+
+	req, err := client.NewRequest(http.MethodPost, "projects", &CreateProjectOptions{Name: String("test")}, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, recorded)
+	require.Equal(t, "https://gitlab.example.com/api/v4/projects", recorded.URL.String())
+	require.Equal(t, "token", recorded.Header.Get("PRIVATE-TOKEN"))
+	require.Equal(t, userAgent, recorded.Header.Get("User-Agent"))
+	require.Equal(t, "application/json", recorded.Header.Get("Accept"))
+	require.Equal(t, "application/json", recorded.Header.Get("Content-Type"))
+	require.Equal(t, 5*time.Second, httpClient.Timeout)
+}
+
+func TestWithRequestLogger(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		logged []*http.Request
+	)
+
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    r,
+		}, nil
+	})
+
+	client, err := NewOAuthClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithTransport(transport),
+		WithRequestLogger(func(req *http.Request, resp *http.Response, elapsed time.Duration) {
+			require.NotNil(t, resp)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.GreaterOrEqual(t, elapsed, time.Duration(0))
+
+			mu.Lock()
+			defer mu.Unlock()
+			logged = append(logged, req)
+		}),
+	)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := client.NewRequest(http.MethodGet, "test", nil, nil)
+			require.NoError(t, err)
+
+			_, err = client.Do(req, nil)
+			require.NoError(t, err)
+
+			// The request passed to Do must keep its credentials.
+			require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, logged, 5)
+	for _, req := range logged {
+		require.Equal(t, "REDACTED", req.Header.Get("Authorization"))
+		require.Equal(t, "https://gitlab.example.com/api/v4/test", req.URL.String())
+	}
+}
+
+func TestWithRequestLoggerError(t *testing.T) {
+	var (
+		called  bool
+		gotResp *http.Response
+	)
+
+	client, err := NewClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("connection refused")
+		})),
+		WithoutRetries(),
+		WithRequestLogger(func(req *http.Request, resp *http.Response, elapsed time.Duration) {
+			called = true
+			gotResp = resp
+			require.Equal(t, "REDACTED", req.Header.Get("PRIVATE-TOKEN"))
+		}),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "test", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	require.Error(t, err)
+	require.True(t, called)
+	require.Nil(t, gotResp)
+}
+
+type fakeSpan struct {
+	operation string
+	attrs     map[string]interface{}
+	ended     bool
+	err       error
+}
+
+func (s *fakeSpan) SetAttribute(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *fakeSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, operation string, attrs map[string]interface{}) (context.Context, Span) {
+	span := &fakeSpan{operation: operation, attrs: attrs}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestWithTracer(t *testing.T) {
+	tracer := &fakeTracer{}
+
+	client, err := NewClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			status := http.StatusCreated
+			if strings.HasPrefix(r.URL.EscapedPath(), "/api/v4/projects/group%2Fproject") {
+				status = http.StatusNotFound
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{}`)),
+				Request:    r,
+			}, nil
+		})),
+		WithTracer(tracer),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProjectOne(42, nil)
+	require.NoError(t, err)
+
+	_, _, err = client.Projects.GetProjectHook("group/project", 7)
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 2)
+
+	span := tracer.spans[0]
+	require.True(t, span.ended)
+	require.NoError(t, span.err)
+	require.Equal(t, "POST projects/:id/repository_storage_moves", span.operation)
+	require.Equal(t, map[string]interface{}{
+		"http.request.method":       http.MethodPost,
+		"http.route":                "projects/:id/repository_storage_moves",
+		"http.response.status_code": http.StatusCreated,
+	}, span.attrs)
+
+	span = tracer.spans[1]
+	require.True(t, span.ended)
+	require.Error(t, span.err)
+	require.Equal(t, "GET projects/:id/hooks/:id", span.operation)
+	require.Equal(t, http.StatusNotFound, span.attrs["http.response.status_code"])
+}
+
+func TestWithTracerTransportError(t *testing.T) {
+	tracer := &fakeTracer{}
+
+	client, err := NewClient("token",
+		WithBaseURL("https://gitlab.example.com"),
+		WithTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("connection refused")
+		})),
+		WithoutRetries(),
+		WithTracer(tracer),
+	)
+	require.NoError(t, err)
+
+	_, _, err = client.ProjectRepositoryStorageMove.GetStorageMove(1)
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.True(t, span.ended)
+	require.Error(t, span.err)
+	require.Equal(t, "GET project_repository_storage_moves/:id", span.operation)
+	require.NotContains(t, span.attrs, "http.response.status_code")
+}
+
+func TestWithProxy(t *testing.T) {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		require.Equal(t, "gitlab.example.com", r.Host)
+		require.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := NewClient("token",
+		WithBaseURL("http://gitlab.example.com"),
+		WithTransport(&http.Transport{}),
+		WithProxy(proxy.URL),
+	)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "test", nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req, nil)
+	require.NoError(t, err)
+	require.True(t, proxied)
+
+	_, err = NewClient("", WithProxy("://invalid"))
+	require.Error(t, err)
+}
+
+func TestRetryTooManyRequestsThenOK(t *testing.T) {
+	mux, client := setup(t)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"source_storage_name":"default"}`)
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	opt := struct {
+		SourceStorageName string `json:"source_storage_name"`
+	}{"default"}
+
+	req, err := client.NewRequest(http.MethodPost, "project_repository_storage_moves", opt, nil)
+	require.NoError(t, err)
+
+	var moves []*ProjectRepositoryStorageMove
+	resp, err := client.Do(req, &moves)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 2, attempts)
+	require.Len(t, moves, 1)
+}
+
+func TestWithRetryableCheck(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("token",
+		WithBaseURL(server.URL),
+		WithCustomRetryMax(2),
+		WithCustomRetryWaitMinMax(0, 0),
+		WithRetryableCheck(func(resp *Response, err error) bool {
+			return resp.Request.Method != http.MethodPost
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.ProjectRepositoryStorageMove.ScheduleAllStorageMoves(nil)
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+
+	attempts = 0
+	_, _, err = client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(RetrieveAllStorageMovesOptions{})
+	require.Error(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestRateLimitBackoffRetryAfter(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+
+	wait := rateLimitBackoff(100*time.Millisecond, 400*time.Millisecond, 1, resp)
+	require.GreaterOrEqual(t, wait, 3*time.Second)
+	require.Less(t, wait, 3*time.Second+300*time.Millisecond)
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	wait = rateLimitBackoff(100*time.Millisecond, 400*time.Millisecond, 1, resp)
+	require.Greater(t, wait, 58*time.Second)
+
+	resp.Header.Del("Retry-After")
+	wait = rateLimitBackoff(100*time.Millisecond, 400*time.Millisecond, 1, resp)
+	require.Less(t, wait, 400*time.Millisecond)
+}
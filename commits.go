@@ -107,6 +107,35 @@ func (s *CommitsService) ListCommits(pid interface{}, opt *ListCommitsOptions, o
 	return c, resp, nil
 }
 
+// ListCommitsForPathOptions represents the available ListCommitsForPath()
+// options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-repository-commits
+type ListCommitsForPathOptions struct {
+	ListOptions
+	RefName     *string    `url:"ref_name,omitempty" json:"ref_name,omitempty"`
+	Since       *time.Time `url:"since,omitempty" json:"since,omitempty"`
+	Until       *time.Time `url:"until,omitempty" json:"until,omitempty"`
+	FirstParent *bool      `url:"first_parent,omitempty" json:"first_parent,omitempty"`
+}
+
+// ListCommitsForPath gets a list of repository commits in a project that
+// touch the given file path.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-repository-commits
+func (s *CommitsService) ListCommitsForPath(pid interface{}, path string, opt *ListCommitsForPathOptions, options ...RequestOptionFunc) ([]*Commit, *Response, error) {
+	lopt := &ListCommitsOptions{Path: &path}
+	if opt != nil {
+		lopt.ListOptions = opt.ListOptions
+		lopt.RefName = opt.RefName
+		lopt.Since = opt.Since
+		lopt.Until = opt.Until
+		lopt.FirstParent = opt.FirstParent
+	}
+
+	return s.ListCommits(pid, lopt, options...)
+}
+
 // CommitRef represents the reference of branches/tags in a commit.
 //
 // GitLab API docs:
@@ -345,9 +374,9 @@ func (s *CommitsService) GetCommitComments(pid interface{}, sha string, opt *Get
 // https://docs.gitlab.com/ee/api/commits.html#post-comment-to-commit
 type PostCommitCommentOptions struct {
 	Note     *string `url:"note,omitempty" json:"note,omitempty"`
-	Path     *string `url:"path" json:"path"`
-	Line     *int    `url:"line" json:"line"`
-	LineType *string `url:"line_type" json:"line_type"`
+	Path     *string `url:"path,omitempty" json:"path,omitempty"`
+	Line     *int    `url:"line,omitempty" json:"line,omitempty"`
+	LineType *string `url:"line_type,omitempty" json:"line_type,omitempty"`
 }
 
 // PostCommitComment adds a comment to a commit. Optionally you can post
@@ -382,10 +411,11 @@ func (s *CommitsService) PostCommitComment(pid interface{}, sha string, opt *Pos
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#list-the-statuses-of-a-commit
 type GetCommitStatusesOptions struct {
 	ListOptions
-	Ref   *string `url:"ref,omitempty" json:"ref,omitempty"`
-	Stage *string `url:"stage,omitempty" json:"stage,omitempty"`
-	Name  *string `url:"name,omitempty" json:"name,omitempty"`
-	All   *bool   `url:"all,omitempty" json:"all,omitempty"`
+	Ref        *string `url:"ref,omitempty" json:"ref,omitempty"`
+	Stage      *string `url:"stage,omitempty" json:"stage,omitempty"`
+	Name       *string `url:"name,omitempty" json:"name,omitempty"`
+	PipelineID *int    `url:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
+	All        *bool   `url:"all,omitempty" json:"all,omitempty"`
 }
 
 // CommitStatus represents a GitLab commit status.
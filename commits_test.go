@@ -79,14 +79,17 @@ func TestGetCommitStatuses(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/statuses", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "all=true&name=ci%2Fjenkins&page=2&per_page=10&pipeline_id=42&ref=master&stage=test")
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
 	opt := &GetCommitStatusesOptions{
-		Ref:   String("master"),
-		Stage: String("test"),
-		Name:  String("ci/jenkins"),
-		All:   Bool(true),
+		ListOptions: ListOptions{Page: 2, PerPage: 10},
+		Ref:         String("master"),
+		Stage:       String("test"),
+		Name:        String("ci/jenkins"),
+		PipelineID:  Int(42),
+		All:         Bool(true),
 	}
 	statuses, _, err := client.Commits.GetCommitStatuses("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
 	if err != nil {
@@ -336,6 +339,41 @@ func TestCommitsService_ListCommits(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_ListCommitsForPath(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "first_parent=true&page=2&path=docs%2FREADME.md&per_page=20&ref_name=main&since=2023-01-01T00%3A00%3A00Z&until=2023-06-30T00%3A00%3A00Z")
+		fmt.Fprint(w, `[{"id": "6104942438c14ec7bd21c6cd5bd995272b3faff6"}]`)
+	})
+
+	opt := &ListCommitsForPathOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 20},
+		RefName:     String("main"),
+		Since:       Time(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Until:       Time(time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)),
+		FirstParent: Bool(true),
+	}
+
+	want := []*Commit{{ID: "6104942438c14ec7bd21c6cd5bd995272b3faff6"}}
+
+	cs, resp, err := client.Commits.ListCommitsForPath(1, "docs/README.md", opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cs)
+
+	cs, resp, err = client.Commits.ListCommitsForPath(1.01, "docs/README.md", nil)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, cs)
+
+	cs, resp, err = client.Commits.ListCommitsForPath(1, "docs/README.md", nil, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, cs)
+}
+
 func TestCommitsService_GetCommitRefs(t *testing.T) {
 	mux, client := setup(t)
 
@@ -629,6 +667,30 @@ func TestCommitsService_PostCommitComment(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestCommitsService_PostCommitCommentBody(t *testing.T) {
+	mux, client := setup(t)
+
+	var wantBody string
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `{"note": "nice"}`)
+	})
+
+	wantBody = `{"note":"nice"}`
+	_, _, err := client.Commits.PostCommitComment(1, "master", &PostCommitCommentOptions{Note: String("nice")})
+	require.NoError(t, err)
+
+	wantBody = `{"note":"nice","path":"main.go","line":42,"line_type":"new"}`
+	_, _, err = client.Commits.PostCommitComment(1, "master", &PostCommitCommentOptions{
+		Note:     String("nice"),
+		Path:     String("main.go"),
+		Line:     Int(42),
+		LineType: String("new"),
+	})
+	require.NoError(t, err)
+}
+
 func TestCommitsService_ListMergeRequestsByCommit(t *testing.T) {
 	mux, client := setup(t)
 
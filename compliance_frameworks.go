@@ -0,0 +1,235 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ComplianceFrameworksService handles communication with the compliance
+// frameworks related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type ComplianceFrameworksService struct {
+	client *Client
+}
+
+// ComplianceFramework represents a GitLab compliance framework.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type ComplianceFramework struct {
+	ID                            int    `json:"id"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	Color                         string `json:"color"`
+	PipelineConfigurationFullPath string `json:"pipeline_configuration_full_path"`
+	Default                       bool   `json:"default"`
+}
+
+func (c ComplianceFramework) String() string {
+	return Stringify(c)
+}
+
+// ListComplianceFrameworksOptions represents the available
+// ListComplianceFrameworks() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type ListComplianceFrameworksOptions ListOptions
+
+// ListComplianceFrameworks gets a list of compliance frameworks of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+func (s *ComplianceFrameworksService) ListComplianceFrameworks(gid interface{}, opt *ListComplianceFrameworksOptions, options ...RequestOptionFunc) ([]*ComplianceFramework, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/compliance_frameworks", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cfs []*ComplianceFramework
+	resp, err := s.client.Do(req, &cfs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cfs, resp, nil
+}
+
+// GetComplianceFramework gets a single compliance framework of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+func (s *ComplianceFrameworksService) GetComplianceFramework(gid interface{}, framework int, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/compliance_frameworks/%d", PathEscape(group), framework)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf := new(ComplianceFramework)
+	resp, err := s.client.Do(req, cf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cf, resp, nil
+}
+
+// CreateComplianceFrameworkOptions represents the available
+// CreateComplianceFramework() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type CreateComplianceFrameworkOptions struct {
+	Name                          *string `url:"name,omitempty" json:"name,omitempty"`
+	Description                   *string `url:"description,omitempty" json:"description,omitempty"`
+	Color                         *string `url:"color,omitempty" json:"color,omitempty"`
+	PipelineConfigurationFullPath *string `url:"pipeline_configuration_full_path,omitempty" json:"pipeline_configuration_full_path,omitempty"`
+	Default                       *bool   `url:"default,omitempty" json:"default,omitempty"`
+}
+
+// CreateComplianceFramework creates a new compliance framework for a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+func (s *ComplianceFrameworksService) CreateComplianceFramework(gid interface{}, opt *CreateComplianceFrameworkOptions, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/compliance_frameworks", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf := new(ComplianceFramework)
+	resp, err := s.client.Do(req, cf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cf, resp, nil
+}
+
+// UpdateComplianceFrameworkOptions represents the available
+// UpdateComplianceFramework() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+type UpdateComplianceFrameworkOptions struct {
+	Name                          *string `url:"name,omitempty" json:"name,omitempty"`
+	Description                   *string `url:"description,omitempty" json:"description,omitempty"`
+	Color                         *string `url:"color,omitempty" json:"color,omitempty"`
+	PipelineConfigurationFullPath *string `url:"pipeline_configuration_full_path,omitempty" json:"pipeline_configuration_full_path,omitempty"`
+	Default                       *bool   `url:"default,omitempty" json:"default,omitempty"`
+}
+
+// UpdateComplianceFramework updates an existing compliance framework of a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+func (s *ComplianceFrameworksService) UpdateComplianceFramework(gid interface{}, framework int, opt *UpdateComplianceFrameworkOptions, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/compliance_frameworks/%d", PathEscape(group), framework)
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf := new(ComplianceFramework)
+	resp, err := s.client.Do(req, cf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cf, resp, nil
+}
+
+// DeleteComplianceFramework deletes a compliance framework of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/group/compliance_frameworks.html
+func (s *ComplianceFrameworksService) DeleteComplianceFramework(gid interface{}, framework int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/compliance_frameworks/%d", PathEscape(group), framework)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// SetProjectComplianceFrameworkOptions represents the available
+// SetProjectComplianceFramework() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/settings/index.html#add-a-compliance-framework-to-a-project
+type SetProjectComplianceFrameworkOptions struct {
+	FrameworkID *int `url:"framework_id,omitempty" json:"framework_id,omitempty"`
+}
+
+// SetProjectComplianceFramework assigns a compliance framework to a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/project/settings/index.html#add-a-compliance-framework-to-a-project
+func (s *ComplianceFrameworksService) SetProjectComplianceFramework(pid interface{}, opt *SetProjectComplianceFrameworkOptions, options ...RequestOptionFunc) (*ComplianceFramework, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/compliance_frameworks", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf := new(ComplianceFramework)
+	resp, err := s.client.Do(req, cf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cf, resp, nil
+}
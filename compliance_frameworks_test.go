@@ -0,0 +1,173 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplianceFrameworksService_ListComplianceFrameworks(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/compliance_frameworks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+			[
+			   {
+				  "id": 1,
+				  "name": "SOX",
+				  "description": "Sarbanes-Oxley",
+				  "color": "#1aaa55",
+				  "pipeline_configuration_full_path": ".sox.yml@compliance/pipelines",
+				  "default": true
+			   }
+			]
+		`)
+	})
+
+	want := []*ComplianceFramework{
+		{
+			ID:                            1,
+			Name:                          "SOX",
+			Description:                   "Sarbanes-Oxley",
+			Color:                         "#1aaa55",
+			PipelineConfigurationFullPath: ".sox.yml@compliance/pipelines",
+			Default:                       true,
+		},
+	}
+
+	cfs, resp, err := client.ComplianceFrameworks.ListComplianceFrameworks(1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cfs)
+
+	cfs, resp, err = client.ComplianceFrameworks.ListComplianceFrameworks(1.01, nil)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, cfs)
+
+	cfs, resp, err = client.ComplianceFrameworks.ListComplianceFrameworks(1, nil, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, cfs)
+
+	cfs, resp, err = client.ComplianceFrameworks.ListComplianceFrameworks(3, nil)
+	require.Error(t, err)
+	require.Nil(t, cfs)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestComplianceFrameworksService_GetComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/compliance_frameworks/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 2, "name": "HIPAA", "color": "#6699cc"}`)
+	})
+
+	want := &ComplianceFramework{ID: 2, Name: "HIPAA", Color: "#6699cc"}
+
+	cf, resp, err := client.ComplianceFrameworks.GetComplianceFramework(1, 2)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cf)
+
+	cf, resp, err = client.ComplianceFrameworks.GetComplianceFramework(1, 3)
+	require.Error(t, err)
+	require.Nil(t, cf)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestComplianceFrameworksService_CreateComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/compliance_frameworks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"HIPAA","description":"Health data","color":"#6699cc","default":false}`)
+		fmt.Fprint(w, `{"id": 2, "name": "HIPAA", "description": "Health data", "color": "#6699cc"}`)
+	})
+
+	want := &ComplianceFramework{ID: 2, Name: "HIPAA", Description: "Health data", Color: "#6699cc"}
+
+	cf, resp, err := client.ComplianceFrameworks.CreateComplianceFramework(1, &CreateComplianceFrameworkOptions{
+		Name:        String("HIPAA"),
+		Description: String("Health data"),
+		Color:       String("#6699cc"),
+		Default:     Bool(false),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cf)
+}
+
+func TestComplianceFrameworksService_UpdateComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/compliance_frameworks/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"pipeline_configuration_full_path":".hipaa.yml@compliance/pipelines"}`)
+		fmt.Fprint(w, `{"id": 2, "name": "HIPAA", "pipeline_configuration_full_path": ".hipaa.yml@compliance/pipelines"}`)
+	})
+
+	want := &ComplianceFramework{ID: 2, Name: "HIPAA", PipelineConfigurationFullPath: ".hipaa.yml@compliance/pipelines"}
+
+	cf, resp, err := client.ComplianceFrameworks.UpdateComplianceFramework(1, 2, &UpdateComplianceFrameworkOptions{
+		PipelineConfigurationFullPath: String(".hipaa.yml@compliance/pipelines"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cf)
+}
+
+func TestComplianceFrameworksService_DeleteComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/compliance_frameworks/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	resp, err := client.ComplianceFrameworks.DeleteComplianceFramework(1, 2)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	resp, err = client.ComplianceFrameworks.DeleteComplianceFramework(1, 3)
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestComplianceFrameworksService_SetProjectComplianceFramework(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/compliance_frameworks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"framework_id":2}`)
+		fmt.Fprint(w, `{"id": 2, "name": "HIPAA"}`)
+	})
+
+	want := &ComplianceFramework{ID: 2, Name: "HIPAA"}
+
+	cf, resp, err := client.ComplianceFrameworks.SetProjectComplianceFramework(5, &SetProjectComplianceFrameworkOptions{
+		FrameworkID: Int(2),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, cf)
+}
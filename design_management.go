@@ -0,0 +1,184 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DesignManagementService handles communication with the design management
+// related methods of the GitLab API. Designs are only exposed by the GraphQL
+// API, so this service uses the GraphQL endpoint of the configured instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/user/project/issues/design_management.html
+type DesignManagementService struct {
+	client *Client
+}
+
+// Design represents a GitLab design attached to an issue.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#design
+type Design struct {
+	ID            string           `json:"id"`
+	Filename      string           `json:"filename"`
+	FullPath      string           `json:"fullPath"`
+	Image         string           `json:"image"`
+	ImageV432x230 string           `json:"imageV432x230"`
+	Event         string           `json:"event"`
+	Versions      []*DesignVersion `json:"versions"`
+}
+
+func (d Design) String() string {
+	return Stringify(d)
+}
+
+// DesignVersion represents a single version of a GitLab design.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#designversion
+type DesignVersion struct {
+	ID        string     `json:"id"`
+	SHA       string     `json:"sha"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+func (v DesignVersion) String() string {
+	return Stringify(v)
+}
+
+const listIssueDesignsQuery = `query($fullPath: ID!, $iid: String!) {
+  project(fullPath: $fullPath) {
+    issue(iid: $iid) {
+      designCollection {
+        designs {
+          nodes {
+            id
+            filename
+            fullPath
+            image
+            imageV432x230
+            event
+            versions {
+              nodes {
+                id
+                sha
+                createdAt
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// ListIssueDesigns gets a list of all designs of an issue, including the
+// versions of each design. The project must be given by its full path, as
+// the GraphQL API does not accept numeric project IDs.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#designcollection
+func (s *DesignManagementService) ListIssueDesigns(projectPath string, issue int, options ...RequestOptionFunc) ([]*Design, *Response, error) {
+	q := &GraphQLQuery{
+		Query: listIssueDesignsQuery,
+		Variables: map[string]interface{}{
+			"fullPath": projectPath,
+			"iid":      strconv.Itoa(issue),
+		},
+	}
+
+	req, err := s.client.NewGraphQLRequest(q, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data struct {
+		Project *struct {
+			Issue *struct {
+				DesignCollection *struct {
+					Designs struct {
+						Nodes []*struct {
+							*Design
+							Versions struct {
+								Nodes []*DesignVersion `json:"nodes"`
+							} `json:"versions"`
+						} `json:"nodes"`
+					} `json:"designs"`
+				} `json:"designCollection"`
+			} `json:"issue"`
+		} `json:"project"`
+	}
+
+	resp, err := s.client.DoGraphQL(req, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if data.Project == nil {
+		return nil, resp, fmt.Errorf("project %q not found", projectPath)
+	}
+	if data.Project.Issue == nil {
+		return nil, resp, fmt.Errorf("issue %d not found in project %q", issue, projectPath)
+	}
+
+	var designs []*Design
+	if dc := data.Project.Issue.DesignCollection; dc != nil {
+		for _, n := range dc.Designs.Nodes {
+			if n.Design == nil {
+				continue
+			}
+			n.Design.Versions = n.Versions.Nodes
+			designs = append(designs, n.Design)
+		}
+	}
+
+	return designs, resp, nil
+}
+
+// DownloadDesignImage downloads the image of a design version. The image URL
+// is one of the URLs returned by ListIssueDesigns and may be either absolute
+// or relative to the host of the Client.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/reference/#design
+func (s *DesignManagementService) DownloadDesignImage(imageURL string, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
+	baseURL := s.client.BaseURL()
+	u, err := baseURL.Parse(imageURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Never send the client credentials to another host.
+	if u.Scheme != baseURL.Scheme || u.Host != baseURL.Host {
+		return nil, nil, fmt.Errorf("design image URL %q does not belong to %s://%s", imageURL, baseURL.Scheme, baseURL.Host)
+	}
+
+	req, err := s.client.newRequestForURL(http.MethodGet, *u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "*/*")
+
+	imageBuf := new(bytes.Buffer)
+	resp, err := s.client.Do(req, imageBuf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bytes.NewReader(imageBuf.Bytes()), resp, err
+}
@@ -0,0 +1,150 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDesignManagementService_ListIssueDesigns(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var q GraphQLQuery
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		require.Equal(t, map[string]interface{}{"fullPath": "group/project", "iid": "7"}, q.Variables)
+
+		fmt.Fprint(w, `
+			{
+			  "data": {
+			    "project": {
+			      "issue": {
+			        "designCollection": {
+			          "designs": {
+			            "nodes": [
+			              {
+			                "id": "gid://gitlab/DesignManagement::Design/1",
+			                "filename": "homepage.png",
+			                "fullPath": "designs/issue-7/homepage.png",
+			                "image": "https://gitlab.example.com/group/project/-/design_management/designs/1/abc/raw_image",
+			                "imageV432x230": "https://gitlab.example.com/group/project/-/design_management/designs/1/abc/resized_image/v432x230",
+			                "event": "MODIFICATION",
+			                "versions": {
+			                  "nodes": [
+			                    {
+			                      "id": "gid://gitlab/DesignManagement::Version/2",
+			                      "sha": "abc",
+			                      "createdAt": "2023-01-02T00:00:00Z"
+			                    },
+			                    {
+			                      "id": "gid://gitlab/DesignManagement::Version/1",
+			                      "sha": "def",
+			                      "createdAt": "2023-01-01T00:00:00Z"
+			                    }
+			                  ]
+			                }
+			              }
+			            ]
+			          }
+			        }
+			      }
+			    }
+			  }
+			}
+		`)
+	})
+
+	v1 := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	v2 := time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	want := []*Design{{
+		ID:            "gid://gitlab/DesignManagement::Design/1",
+		Filename:      "homepage.png",
+		FullPath:      "designs/issue-7/homepage.png",
+		Image:         "https://gitlab.example.com/group/project/-/design_management/designs/1/abc/raw_image",
+		ImageV432x230: "https://gitlab.example.com/group/project/-/design_management/designs/1/abc/resized_image/v432x230",
+		Event:         "MODIFICATION",
+		Versions: []*DesignVersion{
+			{ID: "gid://gitlab/DesignManagement::Version/2", SHA: "abc", CreatedAt: &v2},
+			{ID: "gid://gitlab/DesignManagement::Version/1", SHA: "def", CreatedAt: &v1},
+		},
+	}}
+
+	designs, resp, err := client.DesignManagement.ListIssueDesigns("group/project", 7)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, designs)
+
+	designs, resp, err = client.DesignManagement.ListIssueDesigns("group/project", 7, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, designs)
+}
+
+func TestDesignManagementService_ListIssueDesignsErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"data": {"project": null}}`)
+	})
+
+	designs, resp, err := client.DesignManagement.ListIssueDesigns("group/missing", 7)
+	require.EqualError(t, err, `project "group/missing" not found`)
+	require.NotNil(t, resp)
+	require.Nil(t, designs)
+}
+
+func TestDesignManagementService_DownloadDesignImage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/group/project/-/design_management/designs/1/abc/raw_image", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "image data")
+	})
+
+	image, resp, err := client.DesignManagement.DownloadDesignImage("/group/project/-/design_management/designs/1/abc/raw_image")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	b, err := io.ReadAll(image)
+	require.NoError(t, err)
+	require.Equal(t, "image data", string(b))
+
+	image, resp, err = client.DesignManagement.DownloadDesignImage("/group/project/-/design_management/designs/1/abc/raw_image", errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, image)
+}
+
+func TestDesignManagementService_DownloadDesignImageOtherHost(t *testing.T) {
+	_, client := setup(t)
+
+	image, resp, err := client.DesignManagement.DownloadDesignImage("https://example.com/group/project/-/design_management/designs/1/abc/raw_image")
+	require.ErrorContains(t, err, "does not belong to")
+	require.Nil(t, resp)
+	require.Nil(t, image)
+}
@@ -740,6 +740,11 @@ func (s *DiscussionsService) CreateMergeRequestDiscussion(pid interface{}, merge
 	if err != nil {
 		return nil, nil, err
 	}
+	if opt != nil && opt.Position != nil {
+		if err := validateImagePosition(opt.Position); err != nil {
+			return nil, nil, err
+		}
+	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/discussions",
 		PathEscape(project),
 		mergeRequest,
@@ -759,6 +764,21 @@ func (s *DiscussionsService) CreateMergeRequestDiscussion(pid interface{}, merge
 	return d, resp, nil
 }
 
+// validateImagePosition checks that a position of type image contains all
+// the attributes GitLab needs to pin a discussion to the image.
+func validateImagePosition(p *NotePosition) error {
+	if p.PositionType != "image" {
+		return nil
+	}
+	if p.Width == nil || p.Height == nil || p.X == nil || p.Y == nil {
+		return fmt.Errorf("image position requires width, height, x and y")
+	}
+	if *p.X < 0 || *p.Y < 0 || *p.X >= *p.Width || *p.Y >= *p.Height {
+		return fmt.Errorf("image position (%d, %d) is outside of the %dx%d image", *p.X, *p.Y, *p.Width, *p.Height)
+	}
+	return nil
+}
+
 // ResolveMergeRequestDiscussionOptions represents the available
 // ResolveMergeRequestDiscussion() options.
 //
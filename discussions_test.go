@@ -2322,6 +2322,31 @@ func TestDiscussionsService_ListCommitDiscussions(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestDiscussionsService_ListCommitDiscussionsPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/repository/commits/abc123/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=2&per_page=50")
+		w.Header().Set("X-Next-Page", "3")
+		w.Header().Set("X-Total-Pages", "4")
+		fmt.Fprint(w, `[{"id": "6a9c1750b37d513a43987b574953fceb50b03ce7", "notes": [{"id": 1126}, {"id": 1127}]}]`)
+	})
+
+	want := []*Discussion{{
+		ID:    "6a9c1750b37d513a43987b574953fceb50b03ce7",
+		Notes: []*Note{{ID: 1126}, {ID: 1127}},
+	}}
+
+	opt := &ListCommitDiscussionsOptions{Page: 2, PerPage: 50}
+
+	ds, resp, err := client.Discussions.ListCommitDiscussions(5, "abc123", opt)
+	require.NoError(t, err)
+	require.Equal(t, want, ds)
+	require.Equal(t, 3, resp.NextPage)
+	require.Equal(t, 4, resp.TotalPages)
+}
+
 func TestDiscussionsService_GetCommitDiscussion(t *testing.T) {
 	mux, client := setup(t)
 
@@ -2741,3 +2766,58 @@ func TestDiscussionsService_DeleteCommitDiscussionNote(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestDiscussionsService_CreateMergeRequestDiscussionImagePosition(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"body":"discussion text","position":{"base_sha":"a","start_sha":"b","head_sha":"c","position_type":"image","new_path":"design.png","width":100,"height":50,"x":0,"y":25}}`)
+		fmt.Fprint(w, `{"id": "6a9c1750b37d513a43987b574953fceb50b03ce7"}`)
+	})
+
+	opt := &CreateMergeRequestDiscussionOptions{
+		Body: String("discussion text"),
+		Position: &NotePosition{
+			BaseSHA:      "a",
+			StartSHA:     "b",
+			HeadSHA:      "c",
+			PositionType: "image",
+			NewPath:      "design.png",
+			Width:        Int(100),
+			Height:       Int(50),
+			X:            Int(0),
+			Y:            Int(25),
+		},
+	}
+
+	d, resp, err := client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, &Discussion{ID: "6a9c1750b37d513a43987b574953fceb50b03ce7"}, d)
+
+	opt.Position.Y = nil
+	d, resp, err = client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, "image position requires width, height, x and y")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+
+	opt.Position.Y = Int(51)
+	d, resp, err = client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, "image position (0, 51) is outside of the 100x50 image")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+
+	// Coordinates are zero based, so the width and height are out of bounds.
+	opt.Position.X, opt.Position.Y = Int(100), Int(25)
+	d, resp, err = client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, "image position (100, 25) is outside of the 100x50 image")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+
+	opt.Position.X, opt.Position.Y = Int(0), Int(50)
+	d, resp, err = client.Discussions.CreateMergeRequestDiscussion(5, 11, opt)
+	require.EqualError(t, err, "image position (0, 50) is outside of the 100x50 image")
+	require.Nil(t, resp)
+	require.Nil(t, d)
+}
@@ -44,32 +44,45 @@ type EpicAuthor struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/epics.html
 type Epic struct {
-	ID                      int         `json:"id"`
-	IID                     int         `json:"iid"`
-	GroupID                 int         `json:"group_id"`
-	ParentID                int         `json:"parent_id"`
-	Title                   string      `json:"title"`
-	Description             string      `json:"description"`
-	State                   string      `json:"state"`
-	Confidential            bool        `json:"confidential"`
-	WebURL                  string      `json:"web_url"`
-	Author                  *EpicAuthor `json:"author"`
-	StartDate               *ISOTime    `json:"start_date"`
-	StartDateIsFixed        bool        `json:"start_date_is_fixed"`
-	StartDateFixed          *ISOTime    `json:"start_date_fixed"`
-	StartDateFromMilestones *ISOTime    `json:"start_date_from_milestones"`
-	DueDate                 *ISOTime    `json:"due_date"`
-	DueDateIsFixed          bool        `json:"due_date_is_fixed"`
-	DueDateFixed            *ISOTime    `json:"due_date_fixed"`
-	DueDateFromMilestones   *ISOTime    `json:"due_date_from_milestones"`
-	CreatedAt               *time.Time  `json:"created_at"`
-	UpdatedAt               *time.Time  `json:"updated_at"`
-	ClosedAt                *time.Time  `json:"closed_at"`
-	Labels                  []string    `json:"labels"`
-	Upvotes                 int         `json:"upvotes"`
-	Downvotes               int         `json:"downvotes"`
-	UserNotesCount          int         `json:"user_notes_count"`
-	URL                     string      `json:"url"`
+	ID                      int              `json:"id"`
+	IID                     int              `json:"iid"`
+	GroupID                 int              `json:"group_id"`
+	ParentID                int              `json:"parent_id"`
+	ParentIID               int              `json:"parent_iid"`
+	Title                   string           `json:"title"`
+	Description             string           `json:"description"`
+	State                   string           `json:"state"`
+	Confidential            bool             `json:"confidential"`
+	WebURL                  string           `json:"web_url"`
+	Author                  *EpicAuthor      `json:"author"`
+	StartDate               *ISOTime         `json:"start_date"`
+	StartDateIsFixed        bool             `json:"start_date_is_fixed"`
+	StartDateFixed          *ISOTime         `json:"start_date_fixed"`
+	StartDateFromMilestones *ISOTime         `json:"start_date_from_milestones"`
+	DueDate                 *ISOTime         `json:"due_date"`
+	DueDateIsFixed          bool             `json:"due_date_is_fixed"`
+	DueDateFixed            *ISOTime         `json:"due_date_fixed"`
+	DueDateFromMilestones   *ISOTime         `json:"due_date_from_milestones"`
+	CreatedAt               *time.Time       `json:"created_at"`
+	UpdatedAt               *time.Time       `json:"updated_at"`
+	ClosedAt                *time.Time       `json:"closed_at"`
+	Labels                  []string         `json:"labels"`
+	Upvotes                 int              `json:"upvotes"`
+	Downvotes               int              `json:"downvotes"`
+	UserNotesCount          int              `json:"user_notes_count"`
+	URL                     string           `json:"url"`
+	HasChildren             bool             `json:"has_children"`
+	HasIssues               bool             `json:"has_issues"`
+	References              *IssueReferences `json:"references"`
+	Links                   *EpicLinks       `json:"_links"`
+}
+
+// EpicLinks represents links of the epic.
+type EpicLinks struct {
+	Self       string `json:"self"`
+	EpicIssues string `json:"epic_issues"`
+	Group      string `json:"group"`
+	Parent     string `json:"parent"`
 }
 
 func (e Epic) String() string {
@@ -82,7 +95,9 @@ func (e Epic) String() string {
 type ListGroupEpicsOptions struct {
 	ListOptions
 	AuthorID                *int       `url:"author_id,omitempty" json:"author_id,omitempty"`
+	NotAuthorID             *int       `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	Labels                  *Labels    `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels               *Labels    `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
 	WithLabelDetails        *bool      `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
 	OrderBy                 *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort                    *string    `url:"sort,omitempty" json:"sort,omitempty"`
@@ -93,6 +93,60 @@ func TestListGroupEpics(t *testing.T) {
 	}
 }
 
+func TestListGroupEpicsNotFiltersAndRelations(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/7/epics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/groups/7/epics?not%5Bauthor_id%5D=26&not%5Blabels%5D=wontfix%2Cduplicate")
+		fmt.Fprint(w, `[{
+			"id": 9,
+			"iid": 2,
+			"parent_id": 8,
+			"parent_iid": 1,
+			"has_children": true,
+			"has_issues": true,
+			"references": {"short": "&2", "relative": "&2", "full": "group&2"},
+			"_links": {
+				"self": "https://gitlab.example.com/api/v4/groups/7/epics/2",
+				"epic_issues": "https://gitlab.example.com/api/v4/groups/7/epics/2/issues",
+				"group": "https://gitlab.example.com/api/v4/groups/7",
+				"parent": "https://gitlab.example.com/api/v4/groups/7/epics/1"
+			}
+		}]`)
+	})
+
+	listGroupEpics := &ListGroupEpicsOptions{
+		NotAuthorID: Int(26),
+		NotLabels:   &Labels{"wontfix", "duplicate"},
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics("7", listGroupEpics)
+	if err != nil {
+		t.Fatalf("Epics.ListGroupEpics returned error: %v", err)
+	}
+
+	want := []*Epic{{
+		ID:          9,
+		IID:         2,
+		ParentID:    8,
+		ParentIID:   1,
+		HasChildren: true,
+		HasIssues:   true,
+		References:  &IssueReferences{Short: "&2", Relative: "&2", Full: "group&2"},
+		Links: &EpicLinks{
+			Self:       "https://gitlab.example.com/api/v4/groups/7/epics/2",
+			EpicIssues: "https://gitlab.example.com/api/v4/groups/7/epics/2/issues",
+			Group:      "https://gitlab.example.com/api/v4/groups/7",
+			Parent:     "https://gitlab.example.com/api/v4/groups/7/epics/1",
+		},
+	}}
+
+	if !reflect.DeepEqual(want, epics) {
+		t.Errorf("Epics.ListGroupEpics returned %+v, want %+v", epics, want)
+	}
+}
+
 func TestCreateEpic(t *testing.T) {
 	mux, client := setup(t)
 
@@ -249,11 +249,27 @@ type GeoNodeStatus struct {
 	LfsObjectsFailedCount                         int    `json:"lfs_objects_failed_count"`
 	LfsObjectsSyncedMissingOnPrimaryCount         int    `json:"lfs_objects_synced_missing_on_primary_count"`
 	LfsObjectsSyncedInPercentage                  string `json:"lfs_objects_synced_in_percentage"`
+	LfsObjectsChecksumTotalCount                  int    `json:"lfs_objects_checksum_total_count"`
+	LfsObjectsChecksummedCount                    int    `json:"lfs_objects_checksummed_count"`
+	LfsObjectsChecksumFailedCount                 int    `json:"lfs_objects_checksum_failed_count"`
+	LfsObjectsRegistryCount                       int    `json:"lfs_objects_registry_count"`
+	LfsObjectsVerificationTotalCount              int    `json:"lfs_objects_verification_total_count"`
+	LfsObjectsVerifiedCount                       int    `json:"lfs_objects_verified_count"`
+	LfsObjectsVerificationFailedCount             int    `json:"lfs_objects_verification_failed_count"`
+	LfsObjectsVerifiedInPercentage                string `json:"lfs_objects_verified_in_percentage"`
 	JobArtifactsCount                             int    `json:"job_artifacts_count"`
 	JobArtifactsSyncedCount                       int    `json:"job_artifacts_synced_count"`
 	JobArtifactsFailedCount                       int    `json:"job_artifacts_failed_count"`
 	JobArtifactsSyncedMissingOnPrimaryCount       int    `json:"job_artifacts_synced_missing_on_primary_count"`
 	JobArtifactsSyncedInPercentage                string `json:"job_artifacts_synced_in_percentage"`
+	JobArtifactsChecksumTotalCount                int    `json:"job_artifacts_checksum_total_count"`
+	JobArtifactsChecksummedCount                  int    `json:"job_artifacts_checksummed_count"`
+	JobArtifactsChecksumFailedCount               int    `json:"job_artifacts_checksum_failed_count"`
+	JobArtifactsRegistryCount                     int    `json:"job_artifacts_registry_count"`
+	JobArtifactsVerificationTotalCount            int    `json:"job_artifacts_verification_total_count"`
+	JobArtifactsVerifiedCount                     int    `json:"job_artifacts_verified_count"`
+	JobArtifactsVerificationFailedCount           int    `json:"job_artifacts_verification_failed_count"`
+	JobArtifactsVerifiedInPercentage              string `json:"job_artifacts_verified_in_percentage"`
 	ContainerRepositoriesCount                    int    `json:"container_repositories_count"`
 	ContainerRepositoriesSyncedCount              int    `json:"container_repositories_synced_count"`
 	ContainerRepositoriesFailedCount              int    `json:"container_repositories_failed_count"`
@@ -390,6 +406,13 @@ type GeoNodeStatus struct {
 	UploadsFailedCount                            int    `json:"uploads_failed_count"`
 	UploadsRegistryCount                          int    `json:"uploads_registry_count"`
 	UploadsSyncedInPercentage                     string `json:"uploads_synced_in_percentage"`
+	UploadsChecksumTotalCount                     int    `json:"uploads_checksum_total_count"`
+	UploadsChecksummedCount                       int    `json:"uploads_checksummed_count"`
+	UploadsChecksumFailedCount                    int    `json:"uploads_checksum_failed_count"`
+	UploadsVerificationTotalCount                 int    `json:"uploads_verification_total_count"`
+	UploadsVerifiedCount                          int    `json:"uploads_verified_count"`
+	UploadsVerificationFailedCount                int    `json:"uploads_verification_failed_count"`
+	UploadsVerifiedInPercentage                   string `json:"uploads_verified_in_percentage"`
 }
 
 // RetrieveStatusOfAllGeoNodes get the list of status of all Geo Nodes.
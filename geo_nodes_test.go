@@ -577,9 +577,22 @@ func TestGeoNodesService_RetrieveStatusOfGeoNode(t *testing.T) {
 		MissingOauthApplication:                    false,
 		AttachmentsCount:                           1,
 		AttachmentsSyncedInPercentage:              "0.00%",
-		LfsObjectsSyncedInPercentage:               "0.00%",
+		LfsObjectsCount:                            4,
+		LfsObjectsSyncedCount:                      3,
+		LfsObjectsSyncedInPercentage:               "75.00%",
+		LfsObjectsChecksumTotalCount:               4,
+		LfsObjectsChecksummedCount:                 4,
+		LfsObjectsRegistryCount:                    4,
+		LfsObjectsVerificationTotalCount:           3,
+		LfsObjectsVerifiedCount:                    2,
+		LfsObjectsVerificationFailedCount:          1,
+		LfsObjectsVerifiedInPercentage:             "50.00%",
 		JobArtifactsCount:                          2,
 		JobArtifactsSyncedInPercentage:             "0.00%",
+		JobArtifactsChecksumTotalCount:             2,
+		JobArtifactsChecksummedCount:               2,
+		JobArtifactsRegistryCount:                  2,
+		JobArtifactsVerifiedInPercentage:           "0.00%",
 		ContainerRepositoriesCount:                 3,
 		ContainerRepositoriesSyncedInPercentage:    "0.00%",
 		DesignRepositoriesCount:                    3,
@@ -653,6 +666,12 @@ func TestGeoNodesService_RetrieveStatusOfGeoNode(t *testing.T) {
 		PipelineArtifactsVerifiedInPercentage:      "0.00%",
 		UploadsCount:                               5,
 		UploadsSyncedInPercentage:                  "0.00%",
+		UploadsChecksumTotalCount:                  5,
+		UploadsChecksummedCount:                    5,
+		UploadsVerificationTotalCount:              5,
+		UploadsVerifiedCount:                       4,
+		UploadsVerificationFailedCount:             1,
+		UploadsVerifiedInPercentage:                "80.00%",
 	}
 
 	gns, resp, err := client.GeoNodes.RetrieveStatusOfGeoNode(1, nil)
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
@@ -46,8 +47,16 @@ const (
 	apiVersionPath = "api/v4/"
 	userAgent      = "go-gitlab"
 
-	headerRateLimit = "RateLimit-Limit"
-	headerRateReset = "RateLimit-Reset"
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
+	headerRateResetTime = "RateLimit-ResetTime"
+	headerRetryAfter    = "Retry-After"
+
+	// Older GitLab versions prefixed the rate limit headers with "X-".
+	headerLegacyRateLimit     = "X-RateLimit-Limit"
+	headerLegacyRateRemaining = "X-RateLimit-Remaining"
+	headerLegacyRateReset     = "X-RateLimit-Reset"
 )
 
 // AuthType represents an authentication type within GitLab.
@@ -78,6 +87,10 @@ type Client struct {
 	// disableRetries is used to disable the default retry logic.
 	disableRetries bool
 
+	// retryableCheck, if set, decides whether a response the default retry
+	// logic would retry is actually retried.
+	retryableCheck func(*Response, error) bool
+
 	// configureLimiterOnce is used to make sure the limiter is configured exactly
 	// once and block all other calls until the initial (one) call is done.
 	configureLimiterOnce sync.Once
@@ -100,11 +113,18 @@ type Client struct {
 	// Default request options applied to every request.
 	defaultRequestOptions []RequestOptionFunc
 
+	// requestLogger is called after every round trip, if set.
+	requestLogger RequestLogger
+
+	// tracer is used to create a span for every request, if set.
+	tracer Tracer
+
 	// User agent used when communicating with the GitLab API.
 	UserAgent string
 
 	// Services used for talking to different parts of the GitLab API.
 	AccessRequests               *AccessRequestsService
+	Analytics                    *AnalyticsService
 	Applications                 *ApplicationsService
 	AuditEvents                  *AuditEventsService
 	Avatar                       *AvatarRequestsService
@@ -112,15 +132,18 @@ type Client struct {
 	Boards                       *IssueBoardsService
 	Branches                     *BranchesService
 	BroadcastMessage             *BroadcastMessagesService
+	BulkImports                  *BulkImportsService
 	CIYMLTemplate                *CIYMLTemplatesService
 	ClusterAgents                *ClusterAgentsService
 	Commits                      *CommitsService
+	ComplianceFrameworks         *ComplianceFrameworksService
 	ContainerRegistry            *ContainerRegistryService
 	CustomAttribute              *CustomAttributesService
 	DeployKeys                   *DeployKeysService
 	DeployTokens                 *DeployTokensService
 	DeploymentMergeRequests      *DeploymentMergeRequestsService
 	Deployments                  *DeploymentsService
+	DesignManagement             *DesignManagementService
 	Discussions                  *DiscussionsService
 	DockerfileTemplate           *DockerfileTemplatesService
 	Environments                 *EnvironmentsService
@@ -143,9 +166,11 @@ type Client struct {
 	GroupLabels                  *GroupLabelsService
 	GroupMembers                 *GroupMembersService
 	GroupMilestones              *GroupMilestonesService
+	GroupRepositoryStorageMove   *GroupRepositoryStorageMoveService
 	GroupVariables               *GroupVariablesService
 	GroupWikis                   *GroupWikisService
 	Groups                       *GroupsService
+	Import                       *ImportService
 	InstanceCluster              *InstanceClustersService
 	InstanceVariables            *InstanceVariablesService
 	Invites                      *InvitesService
@@ -166,6 +191,7 @@ type Client struct {
 	Namespaces                   *NamespacesService
 	Notes                        *NotesService
 	NotificationSettings         *NotificationSettingsService
+	PackageProtectionRules       *PackageProtectionRulesService
 	Packages                     *PackagesService
 	Pages                        *PagesService
 	PagesDomains                 *PagesDomainsService
@@ -182,7 +208,7 @@ type Client struct {
 	ProjectIterations            *ProjectIterationsService
 	ProjectMembers               *ProjectMembersService
 	ProjectMirrors               *ProjectMirrorService
-	ProjectRepositoryStorageMove *ProjectRepositoryStorageMoveService
+	ProjectRepositoryStorageMove ProjectRepositoryStorageMoveServiceInterface
 	ProjectSnippets              *ProjectSnippetsService
 	ProjectTemplates             *ProjectTemplatesService
 	ProjectVariables             *ProjectVariablesService
@@ -191,6 +217,7 @@ type Client struct {
 	ProtectedBranches            *ProtectedBranchesService
 	ProtectedEnvironments        *ProtectedEnvironmentsService
 	ProtectedTags                *ProtectedTagsService
+	RegistryProtectionRules      *RegistryProtectionRulesService
 	ReleaseLinks                 *ReleaseLinksService
 	Releases                     *ReleasesService
 	Repositories                 *RepositoriesService
@@ -202,10 +229,12 @@ type Client struct {
 	ResourceWeightEvents         *ResourceWeightEventsService
 	Runners                      *RunnersService
 	Search                       *SearchService
+	SecurityPolicies             *SecurityPoliciesService
 	Services                     *ServicesService
 	Settings                     *SettingsService
 	Sidekiq                      *SidekiqService
 	Snippets                     *SnippetsService
+	SnippetRepositoryStorageMove *SnippetRepositoryStorageMoveService
 	SystemHooks                  *SystemHooksService
 	Tags                         *TagsService
 	Todos                        *TodosService
@@ -224,6 +253,14 @@ type ListOptions struct {
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// For keyset-based paginated result sets, the value must be "keyset". The
+	// ordering is set with the OrderBy and Sort options of the endpoint.
+	Pagination string `url:"pagination,omitempty" json:"pagination,omitempty"`
+
+	// For keyset-based paginated result sets, the cursor of the page to
+	// retrieve, as returned in Response.Cursor.
+	Cursor string `url:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // RateLimiter describes the interface that all (custom) rate limiters must implement.
@@ -317,11 +354,24 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		c.limiter = rate.NewLimiter(rate.Inf, 0)
 	}
 
+	// Wrap the (possibly custom) request log hook, which is called once
+	// for every attempt, so we can report the number of attempts made.
+	logHook := c.client.RequestLogHook
+	c.client.RequestLogHook = func(l retryablehttp.Logger, req *http.Request, retry int) {
+		if attempts, ok := req.Context().Value(attemptsContextKey{}).(*int); ok {
+			*attempts++
+		}
+		if logHook != nil {
+			logHook(l, req, retry)
+		}
+	}
+
 	// Create the internal timeStats service.
 	timeStats := &timeStatsService{client: c}
 
 	// Create all the public services.
 	c.AccessRequests = &AccessRequestsService{client: c}
+	c.Analytics = &AnalyticsService{client: c}
 	c.Applications = &ApplicationsService{client: c}
 	c.AuditEvents = &AuditEventsService{client: c}
 	c.Avatar = &AvatarRequestsService{client: c}
@@ -329,15 +379,18 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Boards = &IssueBoardsService{client: c}
 	c.Branches = &BranchesService{client: c}
 	c.BroadcastMessage = &BroadcastMessagesService{client: c}
+	c.BulkImports = &BulkImportsService{client: c}
 	c.CIYMLTemplate = &CIYMLTemplatesService{client: c}
 	c.ClusterAgents = &ClusterAgentsService{client: c}
 	c.Commits = &CommitsService{client: c}
+	c.ComplianceFrameworks = &ComplianceFrameworksService{client: c}
 	c.ContainerRegistry = &ContainerRegistryService{client: c}
 	c.CustomAttribute = &CustomAttributesService{client: c}
 	c.DeployKeys = &DeployKeysService{client: c}
 	c.DeployTokens = &DeployTokensService{client: c}
 	c.DeploymentMergeRequests = &DeploymentMergeRequestsService{client: c}
 	c.Deployments = &DeploymentsService{client: c}
+	c.DesignManagement = &DesignManagementService{client: c}
 	c.Discussions = &DiscussionsService{client: c}
 	c.DockerfileTemplate = &DockerfileTemplatesService{client: c}
 	c.Environments = &EnvironmentsService{client: c}
@@ -360,9 +413,11 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.GroupLabels = &GroupLabelsService{client: c}
 	c.GroupMembers = &GroupMembersService{client: c}
 	c.GroupMilestones = &GroupMilestonesService{client: c}
+	c.GroupRepositoryStorageMove = &GroupRepositoryStorageMoveService{client: c}
 	c.GroupVariables = &GroupVariablesService{client: c}
 	c.GroupWikis = &GroupWikisService{client: c}
 	c.Groups = &GroupsService{client: c}
+	c.Import = &ImportService{client: c}
 	c.InstanceCluster = &InstanceClustersService{client: c}
 	c.InstanceVariables = &InstanceVariablesService{client: c}
 	c.Invites = &InvitesService{client: c}
@@ -383,6 +438,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Namespaces = &NamespacesService{client: c}
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
+	c.PackageProtectionRules = &PackageProtectionRulesService{client: c}
 	c.Packages = &PackagesService{client: c}
 	c.Pages = &PagesService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
@@ -408,6 +464,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.ProtectedBranches = &ProtectedBranchesService{client: c}
 	c.ProtectedEnvironments = &ProtectedEnvironmentsService{client: c}
 	c.ProtectedTags = &ProtectedTagsService{client: c}
+	c.RegistryProtectionRules = &RegistryProtectionRulesService{client: c}
 	c.ReleaseLinks = &ReleaseLinksService{client: c}
 	c.Releases = &ReleasesService{client: c}
 	c.Repositories = &RepositoriesService{client: c}
@@ -419,10 +476,12 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.ResourceWeightEvents = &ResourceWeightEventsService{client: c}
 	c.Runners = &RunnersService{client: c}
 	c.Search = &SearchService{client: c}
+	c.SecurityPolicies = &SecurityPoliciesService{client: c}
 	c.Services = &ServicesService{client: c}
 	c.Settings = &SettingsService{client: c}
 	c.Sidekiq = &SidekiqService{client: c}
 	c.Snippets = &SnippetsService{client: c}
+	c.SnippetRepositoryStorageMove = &SnippetRepositoryStorageMoveService{client: c}
 	c.SystemHooks = &SystemHooksService{client: c}
 	c.Tags = &TagsService{client: c}
 	c.Todos = &TodosService{client: c}
@@ -435,8 +494,13 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	return c, nil
 }
 
+// attemptsContextKey is the context key used to count the attempts
+// made by the retry wrapper for a single request.
+type attemptsContextKey struct{}
+
 // retryHTTPCheck provides a callback for Client.CheckRetry which
-// will retry both rate limit (429) and server (>= 500) errors.
+// will retry both rate limit (429) and server (>= 500) errors. When a
+// RetryableCheck is configured, it has the final say on those retries.
 func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
 	if ctx.Err() != nil {
 		return false, ctx.Err()
@@ -445,6 +509,9 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 		return false, err
 	}
 	if !c.disableRetries && (resp.StatusCode == 429 || resp.StatusCode >= 500) {
+		if c.retryableCheck != nil {
+			return c.retryableCheck(newResponse(resp), nil), nil
+		}
 		return true, nil
 	}
 	return false, nil
@@ -466,8 +533,9 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 }
 
 // rateLimitBackoff provides a callback for Client.Backoff which will use the
-// RateLimit-Reset header to determine the time to wait. We add some jitter
-// to prevent a thundering herd.
+// RateLimit-Reset header, or the Retry-After header when no reset time is
+// given, to determine the time to wait. We add some jitter to prevent a
+// thundering herd.
 //
 // min and max are mainly used for bounding the jitter that will be added to
 // the reset time retrieved from the headers. But if the final wait time is
@@ -487,12 +555,53 @@ func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Respons
 					min = wait
 				}
 			}
+		} else if wait, ok := ParseRetryAfter(resp.Header); ok && wait > min {
+			min = wait
 		}
 	}
 
 	return min + jitter
 }
 
+// maxRetryAfter is the longest wait ParseRetryAfter returns, so a bogus
+// Retry-After header can't stall the client forever.
+const maxRetryAfter = 10 * time.Minute
+
+// ParseRetryAfter parses the Retry-After header in h, which is either a
+// number of seconds or an HTTP date, into the time to wait. Waits longer
+// than 10 minutes are clamped to 10 minutes, and a date in the past results
+// in no wait at all. It returns false when the header is missing or can't be
+// parsed.
+func ParseRetryAfter(h http.Header) (time.Duration, bool) {
+	v := strings.TrimSpace(h.Get(headerRetryAfter))
+	if v == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		if seconds > int64(maxRetryAfter/time.Second) {
+			return maxRetryAfter, true
+		}
+		wait = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(v); err == nil {
+		wait = time.Until(t)
+	} else {
+		return 0, false
+	}
+
+	switch {
+	case wait < 0:
+		wait = 0
+	case wait > maxRetryAfter:
+		wait = maxRetryAfter
+	}
+	return wait, true
+}
+
 // configureLimiter configures the rate limiter.
 func (c *Client) configureLimiter(ctx context.Context, headers http.Header) {
 	if v := headers.Get(headerRateLimit); v != "" {
@@ -525,6 +634,10 @@ func (c *Client) BaseURL() *url.URL {
 	return &u
 }
 
+// ErrInvalidBaseURL is matched by errors.Is when a client is configured with
+// a base URL that is not an absolute http or https URL.
+var ErrInvalidBaseURL = errors.New("invalid base URL")
+
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
 	// Make sure the given URL end with a slash
@@ -534,7 +647,16 @@ func (c *Client) setBaseURL(urlStr string) error {
 
 	baseURL, err := url.Parse(urlStr)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w %q: %v", ErrInvalidBaseURL, urlStr, err)
+	}
+
+	switch {
+	case baseURL.Scheme != "http" && baseURL.Scheme != "https":
+		return fmt.Errorf("%w %q: scheme must be http or https", ErrInvalidBaseURL, urlStr)
+	case baseURL.Host == "":
+		return fmt.Errorf("%w %q: missing host", ErrInvalidBaseURL, urlStr)
+	case baseURL.RawQuery != "" || baseURL.Fragment != "":
+		return fmt.Errorf("%w %q: must not contain a query or fragment", ErrInvalidBaseURL, urlStr)
 	}
 
 	if !strings.HasSuffix(baseURL.Path, apiVersionPath) {
@@ -563,6 +685,14 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 	u.RawPath = c.baseURL.Path + path
 	u.Path = c.baseURL.Path + unescaped
 
+	return c.newRequestForURL(method, u, opt, options)
+}
+
+// newRequestForURL creates a request for the given absolute URL, applying the
+// same headers, encoding and request options as NewRequest.
+func (c *Client) newRequestForURL(method string, u url.URL, opt interface{}, options []RequestOptionFunc) (*retryablehttp.Request, error) {
+	var err error
+
 	// Create a request specific headers map.
 	reqHeaders := make(http.Header)
 	reqHeaders.Set("Accept", "application/json")
@@ -704,12 +834,49 @@ type Response struct {
 	CurrentPage  int
 	NextPage     int
 	PreviousPage int
+
+	// HasTotals reports whether the X-Total and X-Total-Pages headers were
+	// present, so TotalItems and TotalPages can be trusted. GitLab omits them
+	// for some endpoints and for very large result sets.
+	HasTotals bool
+
+	// These fields provide the links for paginating through a set of results
+	// using keyset-based pagination, as found in the Link header. When keyset
+	// pagination is used, the page values above are left zero.
+	NextLink     string
+	PreviousLink string
+	FirstLink    string
+	LastLink     string
+
+	// Cursor is the cursor parameter of NextLink, if any. It can be passed
+	// as ListOptions.Cursor to retrieve the next page of results.
+	Cursor string
+
+	// These fields provide the rate limit values reported by GitLab. They
+	// are left zero when the corresponding headers are missing.
+	RateLimit          int
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+
+	// Duration is the time it took to complete the request, including any
+	// retries. Attempts is the number of HTTP attempts made by the retry
+	// wrapper, which is 1 if the first attempt succeeded.
+	Duration time.Duration
+	Attempts int
+
+	// DryRun is true when the request was made WithDryRun and therefore not
+	// sent. The request that would have been sent is found in Request.
+	DryRun bool
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
-	response.populatePageValues()
+	response.populateLinkValues()
+	if !response.isKeysetPaginated() {
+		response.populatePageValues()
+	}
+	response.populateRateLimitValues()
 	return response
 }
 
@@ -720,17 +887,72 @@ const (
 	xPage       = "X-Page"
 	xNextPage   = "X-Next-Page"
 	xPrevPage   = "X-Prev-Page"
+
+	linkNext  = "next"
+	linkPrev  = "prev"
+	linkFirst = "first"
+	linkLast  = "last"
+
+	keysetPagination = "keyset"
 )
 
+// isKeysetPaginated reports whether the request that led to this response
+// asked for keyset-based pagination.
+func (r *Response) isKeysetPaginated() bool {
+	if r.Request == nil || r.Request.URL == nil {
+		return false
+	}
+	return r.Request.URL.Query().Get("pagination") == keysetPagination
+}
+
+// populateLinkValues parses the HTTP Link response header and populates the
+// various keyset pagination link values in the Response.
+func (r *Response) populateLinkValues() {
+	for _, link := range strings.Split(r.Header.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		linkURL := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "rel=") {
+				continue
+			}
+
+			switch strings.Trim(strings.TrimPrefix(param, "rel="), `"`) {
+			case linkNext:
+				r.NextLink = linkURL
+			case linkPrev:
+				r.PreviousLink = linkURL
+			case linkFirst:
+				r.FirstLink = linkURL
+			case linkLast:
+				r.LastLink = linkURL
+			}
+		}
+	}
+
+	if r.NextLink != "" {
+		if u, err := url.Parse(r.NextLink); err == nil {
+			r.Cursor = u.Query().Get("cursor")
+		}
+	}
+}
+
 // populatePageValues parses the HTTP Link response headers and populates the
 // various pagination link values in the Response.
 func (r *Response) populatePageValues() {
-	if totalItems := r.Header.Get(xTotal); totalItems != "" {
-		r.TotalItems, _ = strconv.Atoi(totalItems)
+	var itemsErr, pagesErr error
+	totalItems, totalPages := r.Header.Get(xTotal), r.Header.Get(xTotalPages)
+	if totalItems != "" {
+		r.TotalItems, itemsErr = strconv.Atoi(totalItems)
 	}
-	if totalPages := r.Header.Get(xTotalPages); totalPages != "" {
-		r.TotalPages, _ = strconv.Atoi(totalPages)
+	if totalPages != "" {
+		r.TotalPages, pagesErr = strconv.Atoi(totalPages)
 	}
+	r.HasTotals = totalItems != "" && totalPages != "" && itemsErr == nil && pagesErr == nil
 	if itemsPerPage := r.Header.Get(xPerPage); itemsPerPage != "" {
 		r.ItemsPerPage, _ = strconv.Atoi(itemsPerPage)
 	}
@@ -745,12 +967,48 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// populateRateLimitValues parses the rate limit headers, using the legacy
+// "X-" prefixed headers when the current ones are missing.
+func (r *Response) populateRateLimitValues() {
+	if limit := firstHeader(r.Header, headerRateLimit, headerLegacyRateLimit); limit != "" {
+		r.RateLimit, _ = strconv.Atoi(limit)
+	}
+	if remaining := firstHeader(r.Header, headerRateRemaining, headerLegacyRateRemaining); remaining != "" {
+		r.RateLimitRemaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := firstHeader(r.Header, headerRateReset, headerLegacyRateReset); reset != "" {
+		if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil && seconds > 0 {
+			r.RateLimitReset = time.Unix(seconds, 0)
+		}
+	}
+	if r.RateLimitReset.IsZero() {
+		if resetTime := r.Header.Get(headerRateResetTime); resetTime != "" {
+			r.RateLimitReset, _ = http.ParseTime(resetTime)
+		}
+	}
+}
+
+// firstHeader returns the value of the first of the given headers that is
+// set.
+func firstHeader(h http.Header, keys ...string) string {
+	for _, key := range keys {
+		if v := h.Get(key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead && isDryRun(req.Context()) {
+		return dryRunResponse(req)
+	}
+
 	// Wait will block until the limiter can obtain a new token.
 	err := c.limiter.Wait(req.Context())
 	if err != nil {
@@ -787,12 +1045,30 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		}
 	}
 
+	// Count the attempts made by the retry wrapper for this request.
+	attempts := new(int)
+	req = req.WithContext(context.WithValue(req.Context(), attemptsContextKey{}, attempts))
+
+	var span Span
+	if c.tracer != nil {
+		var ctx context.Context
+		ctx, span = c.startSpan(req)
+		req = req.WithContext(ctx)
+	}
+
+	start := time.Now()
 	resp, err := c.client.Do(req)
+	elapsed := time.Since(start)
+	if c.requestLogger != nil {
+		c.requestLogger(redactRequest(req.Request), resp, elapsed)
+	}
 	if err != nil {
+		endSpan(span, nil, err)
 		return nil, err
 	}
 
 	if resp.StatusCode == http.StatusUnauthorized && c.authType == BasicAuth {
+		endSpan(span, resp, nil)
 		resp.Body.Close()
 		// The token most likely expired, so we need to request a new one and try again.
 		if _, err := c.requestOAuthToken(req.Context(), basicAuthToken); err != nil {
@@ -808,18 +1084,26 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 	c.configureLimiterOnce.Do(func() { c.configureLimiter(req.Context(), resp.Header) })
 
 	response := newResponse(resp)
+	response.Duration = elapsed
+	response.Attempts = *attempts
 
 	err = CheckResponse(resp)
+	endSpan(span, resp, err)
 	if err != nil {
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further.
 		return response, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
+	// Responses to HEAD requests never contain a body, so there
+	// is nothing to decode. All metadata is found in the headers.
+	if v != nil && req.Method != http.MethodHead {
+		switch v := v.(type) {
+		case io.Writer:
+			_, err = io.Copy(v, resp.Body)
+		case streamDecoder:
+			err = decodeStream(resp.Body, v)
+		default:
 			err = json.NewDecoder(resp.Body).Decode(v)
 		}
 	}
@@ -827,6 +1111,228 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 	return response, err
 }
 
+// dryRunResponse returns the response for a request made WithDryRun, which
+// describes the request instead of sending it.
+func dryRunResponse(req *retryablehttp.Request) (*Response, error) {
+	body, err := req.BodyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	r := redactRequest(req.Request)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return &Response{
+		Response: &http.Response{
+			Status:  "dry run",
+			Header:  make(http.Header),
+			Body:    http.NoBody,
+			Request: r,
+		},
+		DryRun: true,
+	}, nil
+}
+
+// streamDecoder is passed as the value to decode into by DoStream, so Do
+// decodes the response body one list element at a time.
+type streamDecoder func(json.RawMessage) error
+
+// DoStream sends an API request that returns a JSON list and calls fn for
+// every element of that list, in order, while the response body is being
+// read. Unlike Do, the full list is never held in memory, which keeps memory
+// usage constant for pages with a large number of items. If fn returns an
+// error, reading stops and that error is returned.
+func (c *Client) DoStream(req *retryablehttp.Request, fn func(json.RawMessage) error) (*Response, error) {
+	return c.Do(req, streamDecoder(fn))
+}
+
+// decodeStream decodes the JSON list read from r and calls fn for every
+// element. A null body is treated as an empty list.
+func decodeStream(r io.Reader, fn func(json.RawMessage) error) error {
+	dec := json.NewDecoder(r)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected a JSON list, got %v", tok)
+	}
+
+	for dec.More() {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if err := fn(raw); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing bracket.
+	_, err = dec.Token()
+	return err
+}
+
+// Do sends an API request using c and decodes the response into a newly
+// allocated T, which saves callers from allocating the result themselves:
+//
+//	mr, resp, err := gitlab.Do[gitlab.MergeRequest](client, req)
+//
+// Like Client.Do, it returns the response also when an error occurred.
+func Do[T any](c *Client, req *retryablehttp.Request) (*T, *Response, error) {
+	v := new(T)
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// RequestLogger is called by the client after every round trip with the
+// request, the response, which is nil if no response was received, and the
+// time the round trip took. It may be called concurrently and must not read
+// or close the response body.
+type RequestLogger func(req *http.Request, resp *http.Response, elapsed time.Duration)
+
+// Tracer creates spans for the requests made by the client. It is a minimal
+// interface that can be implemented on top of OpenTelemetry or any other
+// tracing library, without this package depending on it.
+type Tracer interface {
+	// StartSpan starts a span with the given operation name and attributes.
+	// The returned context, which should carry the span, is used to send
+	// the request, so spans created by an instrumented transport become
+	// children of this span.
+	StartSpan(ctx context.Context, operation string, attrs map[string]interface{}) (context.Context, Span)
+}
+
+// Span is a single span created by a Tracer.
+type Span interface {
+	// SetAttribute sets an attribute on the span.
+	SetAttribute(key string, value interface{})
+
+	// End ends the span. The error is nil if the request succeeded.
+	End(err error)
+}
+
+// Attribute keys set on the spans created for requests. They follow the
+// OpenTelemetry semantic conventions for HTTP clients.
+const (
+	spanAttrMethod     = "http.request.method"
+	spanAttrRoute      = "http.route"
+	spanAttrStatusCode = "http.response.status_code"
+)
+
+// startSpan starts a span for req. The span is named after the request
+// method and the path template of the request.
+func (c *Client) startSpan(req *retryablehttp.Request) (context.Context, Span) {
+	route := pathTemplate(strings.TrimPrefix(req.URL.EscapedPath(), c.baseURL.Path))
+	ctx, span := c.tracer.StartSpan(req.Context(), req.Method+" "+route, map[string]interface{}{
+		spanAttrMethod: req.Method,
+		spanAttrRoute:  route,
+	})
+	if ctx == nil {
+		ctx = req.Context()
+	}
+	return ctx, span
+}
+
+// endSpan sets the status code of resp, if any, on span and ends it.
+func endSpan(span Span, resp *http.Response, err error) {
+	if span == nil {
+		return
+	}
+	if resp != nil {
+		span.SetAttribute(spanAttrStatusCode, resp.StatusCode)
+	}
+	span.End(err)
+}
+
+// pathTemplate replaces the IDs in an escaped request path with ":id", so
+// spans for the same endpoint share the same route. Numeric segments, URL
+// encoded namespaced paths, like "group%2Fproject", and the segment following
+// a collection noun, like the SHA in "commits/<sha>" or the branch name in
+// "branches/<name>", are taken as IDs.
+func pathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "":
+			// Keep empty segments, like the one after a trailing slash.
+		case isNumeric(segment), strings.Contains(strings.ToUpper(segment), "%2F"):
+			segments[i] = ":id"
+		case i > 0 && routeCollections[segments[i-1]]:
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// routeCollections lists the path segments that are followed by the name,
+// SHA or path of a resource rather than by a number.
+var routeCollections = map[string]bool{
+	"branches":           true,
+	"commits":            true,
+	"files":              true,
+	"groups":             true,
+	"namespaces":         true,
+	"projects":           true,
+	"protected_branches": true,
+	"protected_tags":     true,
+	"tags":               true,
+	"users":              true,
+	"variables":          true,
+	"wikis":              true,
+}
+
+// isNumeric reports whether s is a non-empty string of decimal digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// redactedHeaders lists the request headers holding credentials.
+var redactedHeaders = []string{"Authorization", "JOB-TOKEN", "PRIVATE-TOKEN"}
+
+// redactRequest returns a copy of req with the values of all credential
+// headers replaced, so it can be passed to a RequestLogger.
+func redactRequest(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	for _, h := range redactedHeaders {
+		if r.Header.Get(h) != "" {
+			r.Header.Set(h, "REDACTED")
+		}
+	}
+	return r
+}
+
+// HeadRequest creates and sends a HEAD request for the given API path. A
+// relative URL path can be provided in path, in which case it is resolved
+// relative to the base URL of the Client. Any options in opt are encoded as
+// query parameters. The returned Response contains the parsed headers.
+func (c *Client) HeadRequest(path string, opt interface{}, options ...RequestOptionFunc) (*Response, error) {
+	req, err := c.NewRequest(http.MethodHead, path, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, nil)
+}
+
 func (c *Client) requestOAuthToken(ctx context.Context, token string) (string, error) {
 	c.tokenLock.Lock()
 	defer c.tokenLock.Unlock()
@@ -876,15 +1382,106 @@ func PathEscape(s string) string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/index.html#data-validation-and-error-reporting
 type ErrorResponse struct {
-	Body     []byte
-	Response *http.Response
-	Message  string
+	Body       []byte
+	Response   *http.Response
+	StatusCode int
+
+	// Message is the plain error message GitLab sent in the string "message"
+	// or "error" field of the body. It is empty when the body carries no such
+	// string, for example for validation errors keyed by attribute.
+	Message string
+
+	// Fields holds the decoded JSON body. It is nil when the body is not a
+	// JSON object.
+	Fields map[string]interface{}
+
+	// text is the flattened body used by Error.
+	text string
 }
 
 func (e *ErrorResponse) Error() string {
+	text := e.text
+	if text == "" {
+		text = e.Message
+	}
 	path, _ := url.QueryUnescape(e.Response.Request.URL.Path)
 	u := fmt.Sprintf("%s://%s%s", e.Response.Request.URL.Scheme, e.Response.Request.URL.Host, path)
-	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, e.Message)
+	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, text)
+}
+
+// ErrInsufficientScope is matched by errors.Is when a request was rejected
+// because the access token used lacks a required scope. Use RequiredScopes
+// to find out which scopes GitLab asked for.
+var ErrInsufficientScope = errors.New("insufficient scope")
+
+// Is reports whether the error response matches target. It supports
+// ErrInsufficientScope.
+func (e *ErrorResponse) Is(target error) bool {
+	return target == ErrInsufficientScope && e.insufficientScope()
+}
+
+func (e *ErrorResponse) insufficientScope() bool {
+	if e.Response == nil || e.Response.StatusCode != http.StatusForbidden {
+		return false
+	}
+	if body := e.scopeErrorBody(); body.Error == "insufficient_scope" {
+		return true
+	}
+	return strings.Contains(e.Response.Header.Get("WWW-Authenticate"), `error="insufficient_scope"`)
+}
+
+// scopeError is the OAuth2 style error GitLab returns for requests made with
+// an under-scoped token.
+type scopeError struct {
+	Error string `json:"error"`
+	Scope string `json:"scope"`
+}
+
+func (e *ErrorResponse) scopeErrorBody() scopeError {
+	var body scopeError
+	_ = json.Unmarshal(e.Body, &body)
+	return body
+}
+
+// RequiredScopes returns the scopes GitLab reported as required when err is
+// caused by an access token that lacks a required scope. It returns nil for
+// any other error.
+func RequiredScopes(err error) []string {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || !errResp.insufficientScope() {
+		return nil
+	}
+
+	scope := errResp.scopeErrorBody().Scope
+	if scope == "" {
+		header := errResp.Response.Header.Get("WWW-Authenticate")
+		if i := strings.Index(header, `scope="`); i >= 0 {
+			scope = header[i+len(`scope="`):]
+			scope = scope[:strings.IndexByte(scope+`"`, '"')]
+		}
+	}
+
+	return strings.Fields(scope)
+}
+
+// IsNotFound reports whether err is an ErrorResponse for a request that
+// GitLab answered with 404 Not Found.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsForbidden reports whether err is an ErrorResponse for a request that
+// GitLab answered with 403 Forbidden.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == code
 }
 
 // CheckResponse checks the API response for errors, and returns them if present.
@@ -894,16 +1491,25 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
 	data, err := io.ReadAll(r.Body)
 	if err == nil && data != nil {
 		errorResponse.Body = data
 
 		var raw interface{}
 		if err := json.Unmarshal(data, &raw); err != nil {
-			errorResponse.Message = fmt.Sprintf("failed to parse unknown error format: %s", data)
+			errorResponse.text = fmt.Sprintf("failed to parse unknown error format: %s", data)
 		} else {
-			errorResponse.Message = parseError(raw)
+			errorResponse.text = parseError(raw)
+		}
+
+		if fields, ok := raw.(map[string]interface{}); ok {
+			errorResponse.Fields = fields
+			if msg, ok := fields["message"].(string); ok {
+				errorResponse.Message = msg
+			} else if msg, ok := fields["error"].(string); ok {
+				errorResponse.Message = msg
+			}
 		}
 	}
 
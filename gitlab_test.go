@@ -19,12 +19,15 @@ package gitlab
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -121,6 +124,54 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNewClientBaseURL(t *testing.T) {
+	valid := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: "https://gitlab.example.com", want: "https://gitlab.example.com/api/v4/projects/1/repository_storage_moves"},
+		{baseURL: "https://gitlab.example.com/", want: "https://gitlab.example.com/api/v4/projects/1/repository_storage_moves"},
+		{baseURL: "https://gitlab.example.com/api/v4", want: "https://gitlab.example.com/api/v4/projects/1/repository_storage_moves"},
+		{baseURL: "https://gitlab.example.com/api/v4/", want: "https://gitlab.example.com/api/v4/projects/1/repository_storage_moves"},
+		{baseURL: "http://example.com:8080/gitlab", want: "http://example.com:8080/gitlab/api/v4/projects/1/repository_storage_moves"},
+	}
+
+	for _, tt := range valid {
+		c, err := NewClient("", WithBaseURL(tt.baseURL))
+		if err != nil {
+			t.Errorf("NewClient(%q) returned error: %v", tt.baseURL, err)
+			continue
+		}
+
+		req, err := c.NewRequest(http.MethodGet, "projects/1/repository_storage_moves", nil, nil)
+		if err != nil {
+			t.Errorf("NewRequest with base URL %q returned error: %v", tt.baseURL, err)
+			continue
+		}
+		if got := req.URL.String(); got != tt.want {
+			t.Errorf("NewRequest with base URL %q resolved to %s, want %s", tt.baseURL, got, tt.want)
+		}
+	}
+
+	invalid := []string{
+		"gitlab.example.com",
+		"ftp://gitlab.example.com",
+		"https://",
+		"https:///api/v4",
+		"https://gitlab.example.com?private_token=secret",
+		"https://gitlab.example.com/#api",
+		"https://gitlab example.com",
+		"://gitlab.example.com",
+	}
+
+	for _, baseURL := range invalid {
+		_, err := NewClient("", WithBaseURL(baseURL))
+		if !errors.Is(err, ErrInvalidBaseURL) {
+			t.Errorf("NewClient(%q) returned error %v, want %v", baseURL, err, ErrInvalidBaseURL)
+		}
+	}
+}
+
 func TestCheckResponse(t *testing.T) {
 	c, err := NewClient("")
 	if err != nil {
@@ -220,6 +271,59 @@ func TestRequestWithContext(t *testing.T) {
 	}
 }
 
+func TestHeadRequest(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		testURL(t, r, "/api/v4/projects/1/repository/archive?sha=master")
+		w.Header().Set("Content-Disposition", `attachment; filename="archive.tar.gz"`)
+		w.Header().Set("X-Total", "42")
+	})
+
+	opt := struct {
+		SHA *string `url:"sha,omitempty"`
+	}{SHA: String("master")}
+
+	resp, err := client.HeadRequest("projects/1/repository/archive", opt)
+	if err != nil {
+		t.Fatalf("HeadRequest returned error: %v", err)
+	}
+
+	if want := `attachment; filename="archive.tar.gz"`; resp.Header.Get("Content-Disposition") != want {
+		t.Errorf("HeadRequest returned Content-Disposition %q, want %q", resp.Header.Get("Content-Disposition"), want)
+	}
+	if resp.TotalItems != 42 {
+		t.Errorf("HeadRequest returned TotalItems %d, want %d", resp.TotalItems, 42)
+	}
+}
+
+func TestDoHeadRequestSkipsDecoding(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/test", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		w.Header().Set("X-Gitlab-Size", "10")
+	})
+
+	req, err := client.NewRequest(http.MethodHead, "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	var v map[string]interface{}
+	resp, err := client.Do(req, &v)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if v != nil {
+		t.Errorf("Do decoded a body for a HEAD request: %v", v)
+	}
+	if resp.Header.Get("X-Gitlab-Size") != "10" {
+		t.Errorf("Do returned X-Gitlab-Size %q, want %q", resp.Header.Get("X-Gitlab-Size"), "10")
+	}
+}
+
 func loadFixture(filePath string) []byte {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
@@ -229,6 +333,426 @@ func loadFixture(filePath string) []byte {
 	return content
 }
 
+func TestNewResponseOffsetPagination(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects?page=2&per_page=20", nil)
+	resp := newResponse(&http.Response{
+		Request: req,
+		Header: http.Header{
+			"X-Total":       []string{"100"},
+			"X-Total-Pages": []string{"5"},
+			"X-Per-Page":    []string{"20"},
+			"X-Page":        []string{"2"},
+			"X-Next-Page":   []string{"3"},
+			"X-Prev-Page":   []string{"1"},
+			"Link":          []string{`<https://gitlab.example.com/api/v4/projects?page=3&per_page=20>; rel="next", <https://gitlab.example.com/api/v4/projects?page=1&per_page=20>; rel="prev"`},
+		},
+	})
+
+	if resp.TotalItems != 100 || resp.TotalPages != 5 || resp.ItemsPerPage != 20 {
+		t.Errorf("newResponse returned totals %d/%d/%d, want 100/5/20", resp.TotalItems, resp.TotalPages, resp.ItemsPerPage)
+	}
+	if resp.CurrentPage != 2 || resp.NextPage != 3 || resp.PreviousPage != 1 {
+		t.Errorf("newResponse returned pages %d/%d/%d, want 2/3/1", resp.CurrentPage, resp.NextPage, resp.PreviousPage)
+	}
+	if want := "https://gitlab.example.com/api/v4/projects?page=3&per_page=20"; resp.NextLink != want {
+		t.Errorf("newResponse returned NextLink %q, want %q", resp.NextLink, want)
+	}
+	if resp.Cursor != "" {
+		t.Errorf("newResponse returned Cursor %q, want none", resp.Cursor)
+	}
+}
+
+func TestNewResponseHasTotals(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantTotals bool
+		wantItems  int
+		wantPages  int
+	}{
+		{
+			name:       "with totals",
+			header:     http.Header{"X-Total": []string{"120"}, "X-Total-Pages": []string{"6"}, "X-Per-Page": []string{"20"}, "X-Page": []string{"1"}},
+			wantTotals: true,
+			wantItems:  120,
+			wantPages:  6,
+		},
+		{
+			name:   "without totals",
+			header: http.Header{"X-Per-Page": []string{"20"}, "X-Page": []string{"1"}, "X-Next-Page": []string{"2"}},
+		},
+		{
+			name:      "only total items",
+			header:    http.Header{"X-Total": []string{"120"}},
+			wantItems: 120,
+		},
+		{
+			name:      "invalid total pages",
+			header:    http.Header{"X-Total": []string{"120"}, "X-Total-Pages": []string{"many"}},
+			wantItems: 120,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := newResponse(&http.Response{Header: tt.header})
+
+			if resp.HasTotals != tt.wantTotals {
+				t.Errorf("newResponse returned HasTotals %v, want %v", resp.HasTotals, tt.wantTotals)
+			}
+			if resp.TotalItems != tt.wantItems || resp.TotalPages != tt.wantPages {
+				t.Errorf("newResponse returned totals %d/%d, want %d/%d", resp.TotalItems, resp.TotalPages, tt.wantItems, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestNewResponseKeysetPagination(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/project_repository_storage_moves?pagination=keyset&per_page=2", nil)
+	resp := newResponse(&http.Response{
+		Request: req,
+		Header: http.Header{
+			"X-Per-Page":  []string{"2"},
+			"X-Next-Page": []string{"2"},
+			"X-Total":     []string{"3"},
+			"Link":        []string{`<https://gitlab.example.com/api/v4/project_repository_storage_moves?cursor=eyJpZCI6IjQyIn0&pagination=keyset&per_page=2>; rel="next", <https://gitlab.example.com/api/v4/project_repository_storage_moves?pagination=keyset&per_page=2>; rel="first"`},
+		},
+	})
+
+	if resp.TotalItems != 0 || resp.TotalPages != 0 || resp.ItemsPerPage != 0 || resp.NextPage != 0 {
+		t.Errorf("newResponse returned page values %+v for a keyset paginated response, want them zero", resp)
+	}
+	if want := "https://gitlab.example.com/api/v4/project_repository_storage_moves?cursor=eyJpZCI6IjQyIn0&pagination=keyset&per_page=2"; resp.NextLink != want {
+		t.Errorf("newResponse returned NextLink %q, want %q", resp.NextLink, want)
+	}
+	if want := "https://gitlab.example.com/api/v4/project_repository_storage_moves?pagination=keyset&per_page=2"; resp.FirstLink != want {
+		t.Errorf("newResponse returned FirstLink %q, want %q", resp.FirstLink, want)
+	}
+	if resp.PreviousLink != "" || resp.LastLink != "" {
+		t.Errorf("newResponse returned PreviousLink %q and LastLink %q, want none", resp.PreviousLink, resp.LastLink)
+	}
+	if want := "eyJpZCI6IjQyIn0"; resp.Cursor != want {
+		t.Errorf("newResponse returned Cursor %q, want %q", resp.Cursor, want)
+	}
+}
+
+func TestDoGeneric(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 42, "iid": 2, "title": "Fix it"}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "projects/1/merge_requests/2", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	mr, resp, err := Do[MergeRequest](client, req)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp == nil {
+		t.Fatal("Do returned no response")
+	}
+	if mr.ID != 42 || mr.IID != 2 || mr.Title != "Fix it" {
+		t.Errorf("Do returned %+v, want ID 42, IID 2 and title %q", mr, "Fix it")
+	}
+
+	req, err = client.NewRequest(http.MethodGet, "projects/1/merge_requests/3", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	mr, resp, err = Do[MergeRequest](client, req)
+	if err == nil {
+		t.Fatal("Do returned no error for a missing merge request")
+	}
+	if mr != nil {
+		t.Errorf("Do returned %+v, want nil", mr)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Do returned response %+v, want status 404", resp)
+	}
+}
+
+func TestDoReportsAttemptsAndDuration(t *testing.T) {
+	mux, client := setup(t)
+
+	calls := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "projects/1", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.Attempts != 2 {
+		t.Errorf("Do returned %d attempts, want 2", resp.Attempts)
+	}
+	if resp.Duration <= 0 {
+		t.Errorf("Do returned duration %v, want a positive duration", resp.Duration)
+	}
+
+	resp, err = client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.Attempts != 1 {
+		t.Errorf("Do returned %d attempts, want 1", resp.Attempts)
+	}
+}
+
+func TestDoStream(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id": 1, "name": "one"}, {"id": 2, "name": "two"}, {"id": 3, "name": "three"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/null", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `null`)
+	})
+	mux.HandleFunc("/api/v4/projects/object", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "projects", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	var names []string
+	_, err = client.DoStream(req, func(raw json.RawMessage) error {
+		var p Project
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return err
+		}
+		names = append(names, p.Name)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("DoStream returned error: %v", err)
+	}
+	if want := []string{"one", "two", "three"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("DoStream decoded %v, want %v", names, want)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	_, err = client.DoStream(req, func(json.RawMessage) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("DoStream returned %v after %d calls, want %v after 1 call", err, calls, errStop)
+	}
+
+	req, err = client.NewRequest(http.MethodGet, "projects/null", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err = client.DoStream(req, func(json.RawMessage) error {
+		t.Error("DoStream called fn for a null body")
+		return nil
+	}); err != nil {
+		t.Errorf("DoStream returned error for a null body: %v", err)
+	}
+
+	req, err = client.NewRequest(http.MethodGet, "projects/object", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err = client.DoStream(req, func(json.RawMessage) error { return nil }); err == nil {
+		t.Error("DoStream returned no error for an object body")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+		approx bool
+	}{
+		{name: "seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "padded seconds", value: " 5 ", want: 5 * time.Second, wantOK: true},
+		{name: "huge seconds", value: "99999999999999999", want: maxRetryAfter, wantOK: true},
+		{name: "negative seconds", value: "-5"},
+		{name: "http date", value: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), want: time.Minute, wantOK: true, approx: true},
+		{name: "past http date", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+		{name: "far future http date", value: "Fri, 31 Dec 9999 23:59:59 GMT", want: maxRetryAfter, wantOK: true},
+		{name: "missing"},
+		{name: "garbage", value: "soon"},
+		{name: "fractional seconds", value: "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := make(http.Header)
+			if tt.value != "" {
+				h.Set("Retry-After", tt.value)
+			}
+
+			got, ok := ParseRetryAfter(h)
+			if ok != tt.wantOK {
+				t.Fatalf("ParseRetryAfter(%q) returned ok %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if tt.approx {
+				// HTTP dates have a resolution of one second.
+				if got <= tt.want-2*time.Second || got > tt.want {
+					t.Errorf("ParseRetryAfter(%q) returned %v, want about %v", tt.value, got, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseRetryAfter(%q) returned %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResponseRateLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		wantLimit     int
+		wantRemaining int
+		wantReset     time.Time
+	}{
+		{
+			name:    "missing",
+			headers: map[string]string{},
+		},
+		{
+			name: "current",
+			headers: map[string]string{
+				"RateLimit-Limit":     "600",
+				"RateLimit-Remaining": "599",
+				"RateLimit-Reset":     "1700000000",
+			},
+			wantLimit:     600,
+			wantRemaining: 599,
+			wantReset:     time.Unix(1700000000, 0),
+		},
+		{
+			name: "lower case",
+			headers: map[string]string{
+				"ratelimit-limit":     "600",
+				"ratelimit-remaining": "0",
+			},
+			wantLimit: 600,
+		},
+		{
+			name: "legacy",
+			headers: map[string]string{
+				"X-RateLimit-Limit":     "100",
+				"X-RateLimit-Remaining": "42",
+				"X-RateLimit-Reset":     "1700000000",
+			},
+			wantLimit:     100,
+			wantRemaining: 42,
+			wantReset:     time.Unix(1700000000, 0),
+		},
+		{
+			name: "reset time",
+			headers: map[string]string{
+				"RateLimit-Limit":     "600",
+				"RateLimit-ResetTime": "Tue, 14 Nov 2023 22:13:20 GMT",
+			},
+			wantLimit: 600,
+			wantReset: time.Unix(1700000000, 0),
+		},
+		{
+			name: "malformed",
+			headers: map[string]string{
+				"RateLimit-Limit":     "many",
+				"RateLimit-Remaining": "",
+				"RateLimit-Reset":     "soon",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := make(http.Header)
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			resp := newResponse(&http.Response{Header: header})
+
+			if resp.RateLimit != tt.wantLimit {
+				t.Errorf("RateLimit is %d, want %d", resp.RateLimit, tt.wantLimit)
+			}
+			if resp.RateLimitRemaining != tt.wantRemaining {
+				t.Errorf("RateLimitRemaining is %d, want %d", resp.RateLimitRemaining, tt.wantRemaining)
+			}
+			if !resp.RateLimitReset.Equal(tt.wantReset) {
+				t.Errorf("RateLimitReset is %v, want %v", resp.RateLimitReset, tt.wantReset)
+			}
+		})
+	}
+}
+
+func TestNewRequestKeysetPagination(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	opt := &RetrieveAllStorageMovesOptions{
+		ListOptions: ListOptions{
+			PerPage:    2,
+			Pagination: "keyset",
+			Cursor:     "eyJpZCI6IjQyIn0",
+		},
+		OrderBy: String("id"),
+		Sort:    String("asc"),
+	}
+
+	req, err := c.NewRequest(http.MethodGet, "project_repository_storage_moves", opt, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	want := "cursor=eyJpZCI6IjQyIn0&order_by=id&pagination=keyset&per_page=2&sort=asc"
+	if req.URL.RawQuery != want {
+		t.Errorf("NewRequest returned query %q, want %q", req.URL.RawQuery, want)
+	}
+
+	// Options embedding ListOptions must send their own ordering only once.
+	projOpt := &ListProjectsOptions{
+		ListOptions: ListOptions{Pagination: "keyset"},
+		OrderBy:     String("id"),
+		Sort:        String("asc"),
+	}
+
+	req, err = c.NewRequest(http.MethodGet, "projects", projOpt, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	want = "order_by=id&pagination=keyset&sort=asc"
+	if req.URL.RawQuery != want {
+		t.Errorf("NewRequest returned query %q, want %q", req.URL.RawQuery, want)
+	}
+}
+
 func TestPathEscape(t *testing.T) {
 	want := "diaspora%2Fdiaspora"
 	got := PathEscape("diaspora/diaspora")
@@ -236,3 +760,170 @@ func TestPathEscape(t *testing.T) {
 		t.Errorf("Expected: %s, got %s", want, got)
 	}
 }
+
+func TestDoGraphQLReturnsErrors(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"query":"query { currentUser { id } }"}`)
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "first"}, {"message": "second"}]}`)
+	})
+
+	req, err := client.NewGraphQLRequest(&GraphQLQuery{Query: "query { currentUser { id } }"}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	_, err = client.DoGraphQL(req, nil)
+	if _, ok := err.(*GraphQLErrorResponse); !ok {
+		t.Fatalf("Expected a *GraphQLErrorResponse, got %T: %v", err, err)
+	}
+
+	want := "POST " + client.BaseURL().Scheme + "://" + client.BaseURL().Host + "/api/graphql: first, second"
+	if err.Error() != want {
+		t.Errorf("Expected error: %s, got %s", want, err.Error())
+	}
+}
+
+func TestRequiredScopes(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "insufficient_scope", "error_description": "The request requires higher privileges than provided by the access token.", "scope": "api read_api"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="Protected by OAuth 2.0", error="insufficient_scope", error_description="The request requires higher privileges than provided by the access token.", scope="write_repository"`)
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/api/v4/projects/3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	_, _, err := client.Projects.GetProject(1, nil)
+	if !errors.Is(err, ErrInsufficientScope) {
+		t.Errorf("Expected ErrInsufficientScope, got %v", err)
+	}
+	if got, want := RequiredScopes(err), []string{"api", "read_api"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RequiredScopes returned %v, want %v", got, want)
+	}
+
+	_, _, err = client.Projects.GetProject(2, nil)
+	if !errors.Is(err, ErrInsufficientScope) {
+		t.Errorf("Expected ErrInsufficientScope, got %v", err)
+	}
+	if got, want := RequiredScopes(err), []string{"write_repository"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RequiredScopes returned %v, want %v", got, want)
+	}
+
+	_, _, err = client.Projects.GetProject(3, nil)
+	if errors.Is(err, ErrInsufficientScope) {
+		t.Errorf("Expected a plain forbidden error, got %v", err)
+	}
+	if got := RequiredScopes(err); got != nil {
+		t.Errorf("RequiredScopes returned %v, want nil", got)
+	}
+}
+
+func TestIsNotFoundAndIsForbidden(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Project Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/repository_storage_moves/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "403 Forbidden"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/3/repository_storage_moves/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `<html>Not Found</html>`)
+	})
+
+	tests := []struct {
+		project     int
+		notFound    bool
+		forbidden   bool
+		wantStatus  int
+		wantMessage string
+		wantFields  map[string]interface{}
+		wantError   string
+	}{
+		{
+			1, true, false, http.StatusNotFound,
+			"404 Project Not Found",
+			map[string]interface{}{"message": "404 Project Not Found"},
+			"{message: 404 Project Not Found}",
+		},
+		{
+			2, false, true, http.StatusForbidden,
+			"403 Forbidden",
+			map[string]interface{}{"error": "403 Forbidden"},
+			"{error: 403 Forbidden}",
+		},
+		{
+			3, true, false, http.StatusNotFound,
+			"",
+			nil,
+			"failed to parse unknown error format: <html>Not Found</html>",
+		},
+	}
+
+	for _, tt := range tests {
+		_, _, err := client.ProjectRepositoryStorageMove.GetStorageMoveForProject(tt.project, 1)
+
+		if got := IsNotFound(err); got != tt.notFound {
+			t.Errorf("project %d: IsNotFound returned %t, want %t", tt.project, got, tt.notFound)
+		}
+		if got := IsForbidden(err); got != tt.forbidden {
+			t.Errorf("project %d: IsForbidden returned %t, want %t", tt.project, got, tt.forbidden)
+		}
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			t.Fatalf("project %d: expected an *ErrorResponse, got %T", tt.project, err)
+		}
+		if errResp.StatusCode != tt.wantStatus {
+			t.Errorf("project %d: StatusCode is %d, want %d", tt.project, errResp.StatusCode, tt.wantStatus)
+		}
+		if errResp.Message != tt.wantMessage {
+			t.Errorf("project %d: Message is %q, want %q", tt.project, errResp.Message, tt.wantMessage)
+		}
+		if !reflect.DeepEqual(errResp.Fields, tt.wantFields) {
+			t.Errorf("project %d: Fields is %v, want %v", tt.project, errResp.Fields, tt.wantFields)
+		}
+		if !strings.HasSuffix(err.Error(), tt.wantError) {
+			t.Errorf("project %d: Error() is %q, want suffix %q", tt.project, err.Error(), tt.wantError)
+		}
+	}
+
+	if IsNotFound(nil) || IsForbidden(errors.New("403 Forbidden")) {
+		t.Error("IsNotFound and IsForbidden should only match error responses")
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"projects/42/repository_storage_moves", "projects/:id/repository_storage_moves"},
+		{"projects/group%2Fproject/issues/7", "projects/:id/issues/:id"},
+		{"projects/42/repository/commits/8b090c1b79a14f2bd9e8a738f717824ff53aebad/diff", "projects/:id/repository/commits/:id/diff"},
+		{"projects/42/repository/branches/main", "projects/:id/repository/branches/:id"},
+		{"projects/42/repository/tags/v1.0.0", "projects/:id/repository/tags/:id"},
+		{"projects/42/repository/files/README.md/raw", "projects/:id/repository/files/:id/raw"},
+		{"groups/my-group/members", "groups/:id/members"},
+		{"users/jdoe/projects", "users/:id/projects"},
+		{"projects", "projects"},
+	}
+
+	for _, tt := range tests {
+		if got := pathTemplate(tt.path); got != tt.want {
+			t.Errorf("pathTemplate(%q) returned %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+const graphQLPath = "api/graphql"
+
+// GraphQLQuery represents a query for the GitLab GraphQL API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError represents a single error returned by the GitLab GraphQL API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// GraphQLErrorResponse is returned when a GraphQL query was executed but
+// GitLab reported one or more errors in the response.
+type GraphQLErrorResponse struct {
+	Response *http.Response
+	Errors   []*GraphQLError
+}
+
+func (e *GraphQLErrorResponse) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Message)
+	}
+	path, _ := url.QueryUnescape(e.Response.Request.URL.Path)
+	u := fmt.Sprintf("%s://%s%s", e.Response.Request.URL.Scheme, e.Response.Request.URL.Host, path)
+	return fmt.Sprintf("%s %s: %s", e.Response.Request.Method, u, strings.Join(msgs, ", "))
+}
+
+// NewGraphQLRequest creates a request for the GitLab GraphQL API. The
+// GraphQL endpoint is resolved relative to the base URL of the Client, so
+// it works for both GitLab.com and self-managed instances.
+func (c *Client) NewGraphQLRequest(q *GraphQLQuery, options []RequestOptionFunc) (*retryablehttp.Request, error) {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(c.baseURL.Path, apiVersionPath) + graphQLPath
+	u.RawPath = ""
+
+	return c.newRequestForURL(http.MethodPost, u, q, options)
+}
+
+// DoGraphQL sends a GraphQL request and stores the data of the response in
+// the value pointed to by v. Errors reported by GitLab in the response are
+// returned as a *GraphQLErrorResponse.
+func (c *Client) DoGraphQL(req *retryablehttp.Request, v interface{}) (*Response, error) {
+	var r struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []*GraphQLError `json:"errors"`
+	}
+
+	resp, err := c.Do(req, &r)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(r.Errors) > 0 {
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: r.Errors}
+	}
+
+	if v != nil && len(r.Data) > 0 {
+		err = json.Unmarshal(r.Data, v)
+	}
+
+	return resp, err
+}
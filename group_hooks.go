@@ -216,3 +216,48 @@ func (s *GroupsService) DeleteGroupHook(pid interface{}, hook int, options ...Re
 
 	return s.client.Do(req, nil)
 }
+
+// ListGroupHookEvents gets the events logged for a group hook over the last
+// 7 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#get-group-hook-events
+func (s *GroupsService) ListGroupHookEvents(gid interface{}, hook int, opt *ListHookEventsOptions, options ...RequestOptionFunc) ([]*HookDelivery, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/hooks/%d/events", PathEscape(group), hook)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*HookDelivery
+	resp, err := s.client.Do(req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return events, resp, nil
+}
+
+// ResendGroupHookEvent resends a logged event of a group hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#resend-group-hook-event
+func (s *GroupsService) ResendGroupHookEvent(gid interface{}, hook int, event int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/hooks/%d/events/%d/resend", PathEscape(group), hook, event)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
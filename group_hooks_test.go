@@ -294,3 +294,46 @@ func TestDeleteGroupHook(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestListGroupHookEvents(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "status=server_failure")
+		fmt.Fprint(w, `[{"id": 3, "url": "https://example.com/hook", "trigger": "push_hooks", "response_status": "500", "execution_duration": 0.25}]`)
+	})
+
+	opt := &ListHookEventsOptions{Status: String("server_failure")}
+
+	events, _, err := client.Groups.ListGroupHookEvents(1, 1, opt)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*HookDelivery{{
+		ID:                3,
+		URL:               "https://example.com/hook",
+		Trigger:           "push_hooks",
+		ResponseStatus:    "500",
+		ExecutionDuration: 0.25,
+	}}
+
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("ListGroupHookEvents returned \ngot:\n%v\nwant:\n%v", Stringify(events), Stringify(want))
+	}
+}
+
+func TestResendGroupHookEvent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/hooks/1/events/3/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"response_status": 200}`)
+	})
+
+	_, err := client.Groups.ResendGroupHookEvent(1, 1, 3)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -55,6 +55,7 @@ type GroupMember struct {
 	ExpiresAt         *ISOTime                 `json:"expires_at"`
 	AccessLevel       AccessLevelValue         `json:"access_level"`
 	Email             string                   `json:"email,omitempty"`
+	Override          bool                     `json:"override"`
 	GroupSAMLIdentity *GroupMemberSAMLIdentity `json:"group_saml_identity"`
 }
 
@@ -334,6 +335,58 @@ func (s *GroupMembersService) EditGroupMember(gid interface{}, user int, opt *Ed
 	return gm, resp, nil
 }
 
+// SetMemberOverride allows the access level of an LDAP synced group member to
+// be overridden, so it is no longer reset by the next LDAP sync.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#override-ldap-synced-member-permissions
+func (s *GroupMembersService) SetMemberOverride(gid interface{}, user int, options ...RequestOptionFunc) (*GroupMember, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/members/%d/override", PathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gm := new(GroupMember)
+	resp, err := s.client.Do(req, gm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gm, resp, nil
+}
+
+// RemoveMemberOverride removes the access level override of an LDAP synced
+// group member, so the LDAP sync manages its access level again.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#remove-override-for-ldap-synced-member
+func (s *GroupMembersService) RemoveMemberOverride(gid interface{}, user int, options ...RequestOptionFunc) (*GroupMember, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/members/%d/override", PathEscape(group), user)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gm := new(GroupMember)
+	resp, err := s.client.Do(req, gm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gm, resp, nil
+}
+
 // RemoveGroupMemberOptions represents the available options to remove a group member.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/members.html#remove-a-member-from-a-group-or-project
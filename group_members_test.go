@@ -287,3 +287,36 @@ func TestListGroupMembersWithSAML(t *testing.T) {
 		t.Errorf("Groups.ListBillableGroupMembers returned %+v, want %+v", members[0], want[0])
 	}
 }
+
+func TestSetAndRemoveMemberOverride(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/members/2/override",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPost:
+				fmt.Fprint(w, `{"id": 2, "username": "raymond_smith", "access_level": 30, "override": true}`)
+			case http.MethodDelete:
+				fmt.Fprint(w, `{"id": 2, "username": "raymond_smith", "access_level": 30, "override": false}`)
+			default:
+				t.Errorf("unexpected request method %s", r.Method)
+			}
+		})
+
+	member, _, err := client.GroupMembers.SetMemberOverride(1, 2)
+	if err != nil {
+		t.Errorf("GroupMembers.SetMemberOverride returned error: %v", err)
+	}
+	want := &GroupMember{ID: 2, Username: "raymond_smith", AccessLevel: DeveloperPermissions, Override: true}
+	assert.Equal(t, want, member)
+
+	member, _, err = client.GroupMembers.RemoveMemberOverride(1, 2)
+	if err != nil {
+		t.Errorf("GroupMembers.RemoveMemberOverride returned error: %v", err)
+	}
+	want.Override = false
+	assert.Equal(t, want, member)
+
+	_, _, err = client.GroupMembers.SetMemberOverride(1.01, 2)
+	assert.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+}
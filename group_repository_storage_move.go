@@ -0,0 +1,193 @@
+//
+// Copyright 2023, Nick Westbury
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GroupRepositoryStorageMoveService handles communication with the
+// group repositories related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html
+type GroupRepositoryStorageMoveService struct {
+	client *Client
+}
+
+// GroupRepositoryStorageMove represents the status of a group repository
+// move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html
+type GroupRepositoryStorageMove struct {
+	ID                     int              `json:"id"`
+	CreatedAt              *time.Time       `json:"created_at"`
+	State                  StorageMoveState `json:"state"`
+	SourceStorageName      string           `json:"source_storage_name"`
+	DestinationStorageName string           `json:"destination_storage_name"`
+	Group                  StorageMoveGroup `json:"group"`
+}
+
+// StorageMoveGroup represents the group summary embedded in a
+// GroupRepositoryStorageMove.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html
+type StorageMoveGroup struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	WebURL string `json:"web_url"`
+}
+
+// RetrieveAllStorageMoves retrieves all group repository storage moves
+// accessible by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#retrieve-all-group-repository-storage-moves
+func (s GroupRepositoryStorageMoveService) RetrieveAllStorageMoves(opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*GroupRepositoryStorageMove, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "group_repository_storage_moves", opts, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gsms []*GroupRepositoryStorageMove
+	resp, err := s.client.Do(req, &gsms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gsms, resp, err
+}
+
+// RetrieveAllStorageMovesForGroup retrieves all repository storage moves for
+// a single group accessible by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-single-group
+func (s GroupRepositoryStorageMoveService) RetrieveAllStorageMovesForGroup(group int, opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*GroupRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("groups/%d/repository_storage_moves", group)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opts, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gsms []*GroupRepositoryStorageMove
+	resp, err := s.client.Do(req, &gsms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gsms, resp, err
+}
+
+// GetStorageMove gets a single group repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#get-a-single-group-repository-storage-move
+func (s GroupRepositoryStorageMoveService) GetStorageMove(repositoryStorage int, options ...RequestOptionFunc) (*GroupRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("group_repository_storage_moves/%d", repositoryStorage)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gsm := new(GroupRepositoryStorageMove)
+	resp, err := s.client.Do(req, gsm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gsm, resp, err
+}
+
+// GetStorageMoveForGroup gets a single repository storage move for a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#get-a-single-repository-storage-move-for-a-group
+func (s GroupRepositoryStorageMoveService) GetStorageMoveForGroup(group int, repositoryStorage int, options ...RequestOptionFunc) (*GroupRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("groups/%d/repository_storage_moves/%d", group, repositoryStorage)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gsm := new(GroupRepositoryStorageMove)
+	resp, err := s.client.Do(req, gsm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gsm, resp, err
+}
+
+// ScheduleAllStorageMoves schedules all group repositories to be moved. If opt
+// is nil, no body is sent and GitLab picks the source and destination
+// storages. GitLab schedules the moves in the background and only
+// acknowledges the request, so use RetrieveAllStorageMoves to find the
+// scheduled moves.
+//
+// This method used to take its options by value and to return a list of
+// moves, which GitLab never sends. Existing calls drop the list return and
+// pass a pointer, or nil for no options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#schedule-repository-storage-moves-for-all-groups-on-a-storage-shard
+func (s GroupRepositoryStorageMoveService) ScheduleAllStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "group_repository_storage_moves", optionalBody(opt), options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ScheduleStorageMoveForGroupOptions represents the available
+// ScheduleStorageMoveForGroup() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-group
+type ScheduleStorageMoveForGroupOptions struct {
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleStorageMoveForGroup schedules a repository to be moved for a
+// group. If opt is nil, GitLab picks the destination storage.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-group
+func (s GroupRepositoryStorageMoveService) ScheduleStorageMoveForGroup(group int, opt *ScheduleStorageMoveForGroupOptions, options ...RequestOptionFunc) (*GroupRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("groups/%d/repository_storage_moves", group)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, optionalBody(opt), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gsm := new(GroupRepositoryStorageMove)
+	resp, err := s.client.Do(req, gsm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gsm, resp, err
+}
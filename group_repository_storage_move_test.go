@@ -0,0 +1,177 @@
+//
+// Copyright 2023, Nick Westbury
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupRepositoryStorageMoveService_RetrieveAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/group_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=1&per_page=2")
+		fmt.Fprint(w, `[{"id": 1, "state": "finished", "group": {"id": 283, "name": "Foo"}}, {"id": 2, "state": "scheduled"}]`)
+	})
+
+	opts := RetrieveAllStorageMovesOptions{ListOptions: ListOptions{Page: 1, PerPage: 2}}
+
+	gsms, resp, err := client.GroupRepositoryStorageMove.RetrieveAllStorageMoves(opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, gsms, 2)
+	require.Equal(t, 283, gsms[0].Group.ID)
+	require.Equal(t, "Foo", gsms[0].Group.Name)
+
+	gsms, resp, err = client.GroupRepositoryStorageMove.RetrieveAllStorageMoves(opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, gsms)
+}
+
+func TestGroupRepositoryStorageMoveService_RetrieveAllStorageMovesForGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/283/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id": 1, "state": "finished", "group": {"id": 283}}]`)
+	})
+
+	gsms, resp, err := client.GroupRepositoryStorageMove.RetrieveAllStorageMovesForGroup(283, RetrieveAllStorageMovesOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, gsms, 1)
+	require.Equal(t, 283, gsms[0].Group.ID)
+
+	gsms, resp, err = client.GroupRepositoryStorageMove.RetrieveAllStorageMovesForGroup(284, RetrieveAllStorageMovesOptions{})
+	require.Error(t, err)
+	require.Nil(t, gsms)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGroupRepositoryStorageMoveService_GetStorageMove(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/group_repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 123, "state": "started", "source_storage_name": "default", "destination_storage_name": "storage2", "group": {"id": 283, "name": "Foo", "web_url": "https://gitlab.example.com/groups/foo"}}`)
+	})
+
+	want := &GroupRepositoryStorageMove{
+		ID:                     123,
+		State:                  StorageMoveStarted,
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Group: StorageMoveGroup{
+			ID:     283,
+			Name:   "Foo",
+			WebURL: "https://gitlab.example.com/groups/foo",
+		},
+	}
+
+	gsm, resp, err := client.GroupRepositoryStorageMove.GetStorageMove(123)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, gsm)
+
+	gsm, resp, err = client.GroupRepositoryStorageMove.GetStorageMove(123, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, gsm)
+
+	gsm, resp, err = client.GroupRepositoryStorageMove.GetStorageMove(124)
+	require.Error(t, err)
+	require.Nil(t, gsm)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGroupRepositoryStorageMoveService_GetStorageMoveForGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/283/repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 123, "state": "finished", "group": {"id": 283}}`)
+	})
+
+	gsm, resp, err := client.GroupRepositoryStorageMove.GetStorageMoveForGroup(283, 123)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, 123, gsm.ID)
+	require.Equal(t, 283, gsm.Group.ID)
+
+	gsm, resp, err = client.GroupRepositoryStorageMove.GetStorageMoveForGroup(283, 124)
+	require.Error(t, err)
+	require.Nil(t, gsm)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGroupRepositoryStorageMoveService_ScheduleAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/group_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message": "202 Accepted"}`)
+	})
+
+	opts := &ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	}
+
+	resp, err := client.GroupRepositoryStorageMove.ScheduleAllStorageMoves(opts)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, err = client.GroupRepositoryStorageMove.ScheduleAllStorageMoves(opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+}
+
+func TestGroupRepositoryStorageMoveService_ScheduleStorageMoveForGroup(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/283/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		fmt.Fprint(w, `{"id": 123, "state": "scheduled", "destination_storage_name": "storage2", "group": {"id": 283}}`)
+	})
+
+	opts := &ScheduleStorageMoveForGroupOptions{DestinationStorageName: String("storage2")}
+
+	gsm, resp, err := client.GroupRepositoryStorageMove.ScheduleStorageMoveForGroup(283, opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, StorageMoveScheduled, gsm.State)
+	require.Equal(t, 283, gsm.Group.ID)
+
+	gsm, resp, err = client.GroupRepositoryStorageMove.ScheduleStorageMoveForGroup(283, opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, gsm)
+
+	gsm, resp, err = client.GroupRepositoryStorageMove.ScheduleStorageMoveForGroup(284, opts)
+	require.Error(t, err)
+	require.Nil(t, gsm)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -328,6 +328,25 @@ func (s *GroupsService) DownloadAvatar(gid interface{}, options ...RequestOption
 	return bytes.NewReader(avatar.Bytes()), resp, err
 }
 
+// StreamAvatar streams a group avatar to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#download-a-group-avatar
+func (s *GroupsService) StreamAvatar(gid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/avatar", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // CreateGroupOptions represents the available CreateGroup() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#new-group
@@ -661,6 +680,45 @@ func (s *GroupsService) ListProvisionedUsers(gid interface{}, opt *ListProvision
 	return us, resp, nil
 }
 
+// ListGroupVulnerabilitiesOptions represents the available
+// ListGroupVulnerabilities() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html
+type ListGroupVulnerabilitiesOptions struct {
+	ListOptions
+	ReportType *[]string `url:"report_type[],omitempty" json:"report_type,omitempty"`
+	Severity   *[]string `url:"severity[],omitempty" json:"severity,omitempty"`
+	State      *[]string `url:"state[],omitempty" json:"state,omitempty"`
+}
+
+// ListGroupVulnerabilities gets a list of the vulnerabilities of all projects
+// in the given group. Use the Project field, or the ProjectID of the Finding,
+// to group the results per project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/vulnerabilities.html
+func (s *GroupsService) ListGroupVulnerabilities(gid interface{}, opt *ListGroupVulnerabilitiesOptions, options ...RequestOptionFunc) ([]*ProjectVulnerability, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/vulnerabilities", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vs []*ProjectVulnerability
+	resp, err := s.client.Do(req, &vs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vs, resp, nil
+}
+
 // ListGroupLDAPLinks lists the group's LDAP links. Available only for users who
 // can edit groups.
 //
@@ -906,6 +964,227 @@ func (s *GroupsService) DeleteGroupSAMLLink(gid interface{}, samlGroupName strin
 	return s.client.Do(req, nil)
 }
 
+// GroupSAMLIdentity represents a SAML identity linked to a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html
+type GroupSAMLIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+}
+
+// ListGroupSAMLIdentities lists the SAML identities of a group. Available
+// only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#get-saml-identities-for-a-group
+func (s *GroupsService) ListGroupSAMLIdentities(gid interface{}, options ...RequestOptionFunc) ([]*GroupSAMLIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/identities", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ids []*GroupSAMLIdentity
+	resp, err := s.client.Do(req, &ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ids, resp, nil
+}
+
+// GetGroupSAMLIdentity gets a single SAML identity of a group by its
+// external UID. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#get-a-single-saml-identity
+func (s *GroupsService) GetGroupSAMLIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*GroupSAMLIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := new(GroupSAMLIdentity)
+	resp, err := s.client.Do(req, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return id, resp, nil
+}
+
+// UpdateGroupSAMLIdentityOptions represents the available
+// UpdateGroupSAMLIdentity() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#update-extern_uid-field-for-a-saml-identity
+type UpdateGroupSAMLIdentityOptions struct {
+	ExternUID *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
+}
+
+// UpdateGroupSAMLIdentity updates the external UID of a SAML identity of
+// a group. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#update-extern_uid-field-for-a-saml-identity
+func (s *GroupsService) UpdateGroupSAMLIdentity(gid interface{}, uid string, opt *UpdateGroupSAMLIdentityOptions, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteGroupSAMLIdentity deletes a SAML identity of a group, unlinking
+// the user from the identity provider. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/saml.html#delete-a-single-saml-identity
+func (s *GroupsService) DeleteGroupSAMLIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/saml/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// GroupSCIMIdentity represents a SCIM identity linked to a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html
+type GroupSCIMIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    bool   `json:"active"`
+}
+
+// ListGroupSCIMIdentities lists the SCIM identities of a group. Available
+// only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#get-scim-identities-for-a-group
+func (s *GroupsService) ListGroupSCIMIdentities(gid interface{}, options ...RequestOptionFunc) ([]*GroupSCIMIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/identities", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ids []*GroupSCIMIdentity
+	resp, err := s.client.Do(req, &ids)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ids, resp, nil
+}
+
+// GetGroupSCIMIdentity gets a single SCIM identity of a group by its
+// external UID. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#get-a-single-scim-identity
+func (s *GroupsService) GetGroupSCIMIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*GroupSCIMIdentity, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := new(GroupSCIMIdentity)
+	resp, err := s.client.Do(req, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return id, resp, nil
+}
+
+// UpdateGroupSCIMIdentityOptions represents the available
+// UpdateGroupSCIMIdentity() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#update-extern_uid-field-for-a-scim-identity
+type UpdateGroupSCIMIdentityOptions struct {
+	ExternUID *string `url:"extern_uid,omitempty" json:"extern_uid,omitempty"`
+}
+
+// UpdateGroupSCIMIdentity updates the external UID of a SCIM identity of
+// a group. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#update-extern_uid-field-for-a-scim-identity
+func (s *GroupsService) UpdateGroupSCIMIdentity(gid interface{}, uid string, opt *UpdateGroupSCIMIdentityOptions, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteGroupSCIMIdentity deletes a SCIM identity of a group, unlinking
+// the user from the identity provider. Available only for group owners.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/scim.html#delete-a-single-scim-identity
+func (s *GroupsService) DeleteGroupSCIMIdentity(gid interface{}, uid string, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/scim/%s", PathEscape(group), PathEscape(uid))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ShareGroupWithGroupOptions represents the available ShareGroupWithGroup() options.
 //
 // GitLab API docs:
@@ -1111,3 +1390,92 @@ func (s *GroupsService) DeleteGroupPushRule(gid interface{}, options ...RequestO
 
 	return s.client.Do(req, nil)
 }
+
+// GroupMergeRequestApprovalSettings represents the merge request approval
+// settings of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#group-level-mr-approvals
+type GroupMergeRequestApprovalSettings struct {
+	AllowAuthorApproval                         *MergeRequestApprovalSetting `json:"allow_author_approval"`
+	AllowCommitterApproval                      *MergeRequestApprovalSetting `json:"allow_committer_approval"`
+	AllowOverridesToApproverListPerMergeRequest *MergeRequestApprovalSetting `json:"allow_overrides_to_approver_list_per_merge_request"`
+	RetainApprovalsOnPush                       *MergeRequestApprovalSetting `json:"retain_approvals_on_push"`
+	RequirePasswordToApprove                    *MergeRequestApprovalSetting `json:"require_password_to_approve"`
+}
+
+// MergeRequestApprovalSetting represents a single merge request approval
+// setting, including whether it is locked for lower levels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#group-level-mr-approvals
+type MergeRequestApprovalSetting struct {
+	Value         bool   `json:"value"`
+	Locked        bool   `json:"locked"`
+	InheritedFrom string `json:"inherited_from"`
+}
+
+// GetMergeRequestApprovalSettings gets the merge request approval settings
+// of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-group-level-mr-approval-settings
+func (s *GroupsService) GetMergeRequestApprovalSettings(gid interface{}, options ...RequestOptionFunc) (*GroupMergeRequestApprovalSettings, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/merge_request_approval_setting", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(GroupMergeRequestApprovalSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// UpdateMergeRequestApprovalSettingsOptions represents the available
+// UpdateMergeRequestApprovalSettings() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-group-level-mr-approval-settings
+type UpdateMergeRequestApprovalSettingsOptions struct {
+	AllowAuthorApproval                         *bool `url:"allow_author_approval,omitempty" json:"allow_author_approval,omitempty"`
+	AllowCommitterApproval                      *bool `url:"allow_committer_approval,omitempty" json:"allow_committer_approval,omitempty"`
+	AllowOverridesToApproverListPerMergeRequest *bool `url:"allow_overrides_to_approver_list_per_merge_request,omitempty" json:"allow_overrides_to_approver_list_per_merge_request,omitempty"`
+	RetainApprovalsOnPush                       *bool `url:"retain_approvals_on_push,omitempty" json:"retain_approvals_on_push,omitempty"`
+	RequirePasswordToApprove                    *bool `url:"require_password_to_approve,omitempty" json:"require_password_to_approve,omitempty"`
+}
+
+// UpdateMergeRequestApprovalSettings updates the merge request approval
+// settings of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#update-group-level-mr-approval-settings
+func (s *GroupsService) UpdateMergeRequestApprovalSettings(gid interface{}, opt *UpdateMergeRequestApprovalSettingsOptions, options ...RequestOptionFunc) (*GroupMergeRequestApprovalSettings, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/merge_request_approval_setting", PathEscape(group))
+
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(GroupMergeRequestApprovalSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
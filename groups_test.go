@@ -1,9 +1,11 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -550,3 +552,238 @@ func TestUpdateGroupWithIPRestrictionRanges(t *testing.T) {
 		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", group, want)
 	}
 }
+
+func TestListGroupVulnerabilities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/vulnerabilities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testParams(t, r, "severity%5B%5D=critical&severity%5B%5D=high&state%5B%5D=detected")
+			fmt.Fprint(w, `[
+				{"id": 1, "severity": "critical", "project": {"id": 2}, "finding": {"id": 3, "project_id": 2}},
+				{"id": 4, "severity": "high", "project": {"id": 5}, "finding": {"id": 6, "project_id": 5}}
+			]`)
+		})
+
+	vulns, _, err := client.Groups.ListGroupVulnerabilities(1, &ListGroupVulnerabilitiesOptions{
+		Severity: &[]string{"critical", "high"},
+		State:    &[]string{"detected"},
+	})
+	if err != nil {
+		t.Errorf("Groups.ListGroupVulnerabilities returned error: %v", err)
+	}
+
+	want := []*ProjectVulnerability{
+		{ID: 1, Severity: "critical", Project: &Project{ID: 2}, Finding: &Finding{ID: 3, ProjectID: 2}},
+		{ID: 4, Severity: "high", Project: &Project{ID: 5}, Finding: &Finding{ID: 6, ProjectID: 5}},
+	}
+	if !reflect.DeepEqual(want, vulns) {
+		t.Errorf("Groups.ListGroupVulnerabilities returned %+v, want %+v", vulns, want)
+	}
+}
+
+func TestGetMergeRequestApprovalSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/merge_request_approval_setting",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `{
+				"allow_author_approval": {"value": false, "locked": true, "inherited_from": "instance"},
+				"allow_committer_approval": {"value": false, "locked": false},
+				"retain_approvals_on_push": {"value": true, "locked": false},
+				"require_password_to_approve": {"value": true, "locked": false}
+			}`)
+		})
+
+	settings, _, err := client.Groups.GetMergeRequestApprovalSettings(1)
+	if err != nil {
+		t.Errorf("Groups.GetMergeRequestApprovalSettings returned error: %v", err)
+	}
+
+	want := &GroupMergeRequestApprovalSettings{
+		AllowAuthorApproval:      &MergeRequestApprovalSetting{Value: false, Locked: true, InheritedFrom: "instance"},
+		AllowCommitterApproval:   &MergeRequestApprovalSetting{Value: false, Locked: false},
+		RetainApprovalsOnPush:    &MergeRequestApprovalSetting{Value: true, Locked: false},
+		RequirePasswordToApprove: &MergeRequestApprovalSetting{Value: true, Locked: false},
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("Groups.GetMergeRequestApprovalSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateMergeRequestApprovalSettings(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/merge_request_approval_setting",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			testBody(t, r, `{"allow_author_approval":false,"require_password_to_approve":true}`)
+			fmt.Fprint(w, `{
+				"allow_author_approval": {"value": false, "locked": false},
+				"require_password_to_approve": {"value": true, "locked": false}
+			}`)
+		})
+
+	settings, _, err := client.Groups.UpdateMergeRequestApprovalSettings(1, &UpdateMergeRequestApprovalSettingsOptions{
+		AllowAuthorApproval:      Bool(false),
+		RequirePasswordToApprove: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Groups.UpdateMergeRequestApprovalSettings returned error: %v", err)
+	}
+
+	want := &GroupMergeRequestApprovalSettings{
+		AllowAuthorApproval:      &MergeRequestApprovalSetting{Value: false, Locked: false},
+		RequirePasswordToApprove: &MergeRequestApprovalSetting{Value: true, Locked: false},
+	}
+	if !reflect.DeepEqual(want, settings) {
+		t.Errorf("Groups.UpdateMergeRequestApprovalSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUploadGroupAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if !strings.Contains(r.Header.Get("Content-Type"), "multipart/form-data;") {
+			t.Fatalf("Groups.UploadAvatar request content-type %+v want multipart/form-data;", r.Header.Get("Content-Type"))
+		}
+		fmt.Fprint(w, `{"id":1,"avatar_url":"http://gitlab.example.com/uploads/-/system/group/avatar/1/avatar.png"}`)
+	})
+
+	avatar := bytes.NewBufferString("avatar")
+	group, _, err := client.Groups.UploadAvatar(1, avatar, "avatar.png")
+	if err != nil {
+		t.Fatalf("Groups.UploadAvatar returns an error: %v", err)
+	}
+
+	want := &Group{ID: 1, AvatarURL: "http://gitlab.example.com/uploads/-/system/group/avatar/1/avatar.png"}
+	if !reflect.DeepEqual(want, group) {
+		t.Errorf("Groups.UploadAvatar returned %+v, want %+v", group, want)
+	}
+}
+
+func TestStreamGroupAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "avatar image")
+	})
+
+	got := new(bytes.Buffer)
+	_, err := client.Groups.StreamAvatar(1, got)
+	if err != nil {
+		t.Fatalf("Groups.StreamAvatar returns an error: %v", err)
+	}
+	if got.String() != "avatar image" {
+		t.Errorf("Groups.StreamAvatar wrote %q, want %q", got.String(), "avatar image")
+	}
+}
+
+func TestGroupSAMLIdentities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/saml/identities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"extern_uid": "yrnZW46BrtBFqM7xDzE7dddd", "user_id": 48}]`)
+		})
+
+	mux.HandleFunc("/api/v4/groups/1/saml/yrnZW46BrtBFqM7xDzE7dddd",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				fmt.Fprint(w, `{"extern_uid": "yrnZW46BrtBFqM7xDzE7dddd", "user_id": 48}`)
+			case http.MethodPatch:
+				testBody(t, r, `{"extern_uid":"be20d8dcc028677c931e04f387"}`)
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request method %s", r.Method)
+			}
+		})
+
+	want := &GroupSAMLIdentity{ExternUID: "yrnZW46BrtBFqM7xDzE7dddd", UserID: 48}
+
+	ids, _, err := client.Groups.ListGroupSAMLIdentities(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned error: %v", err)
+	}
+	if !reflect.DeepEqual([]*GroupSAMLIdentity{want}, ids) {
+		t.Errorf("Groups.ListGroupSAMLIdentities returned %+v, want %+v", ids, want)
+	}
+
+	id, _, err := client.Groups.GetGroupSAMLIdentity(1, "yrnZW46BrtBFqM7xDzE7dddd")
+	if err != nil {
+		t.Errorf("Groups.GetGroupSAMLIdentity returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, id) {
+		t.Errorf("Groups.GetGroupSAMLIdentity returned %+v, want %+v", id, want)
+	}
+
+	opt := &UpdateGroupSAMLIdentityOptions{ExternUID: String("be20d8dcc028677c931e04f387")}
+	if _, err := client.Groups.UpdateGroupSAMLIdentity(1, "yrnZW46BrtBFqM7xDzE7dddd", opt); err != nil {
+		t.Errorf("Groups.UpdateGroupSAMLIdentity returned error: %v", err)
+	}
+
+	if _, err := client.Groups.DeleteGroupSAMLIdentity(1, "yrnZW46BrtBFqM7xDzE7dddd"); err != nil {
+		t.Errorf("Groups.DeleteGroupSAMLIdentity returned error: %v", err)
+	}
+}
+
+func TestGroupSCIMIdentities(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/scim/identities",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, `[{"extern_uid": "be20d8dcc028677c931e04f387", "user_id": 48, "active": true}]`)
+		})
+
+	mux.HandleFunc("/api/v4/groups/1/scim/be20d8dcc028677c931e04f387",
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				fmt.Fprint(w, `{"extern_uid": "be20d8dcc028677c931e04f387", "user_id": 48, "active": true}`)
+			case http.MethodPatch:
+				testBody(t, r, `{"extern_uid":"yrnZW46BrtBFqM7xDzE7dddd"}`)
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodDelete:
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request method %s", r.Method)
+			}
+		})
+
+	want := &GroupSCIMIdentity{ExternUID: "be20d8dcc028677c931e04f387", UserID: 48, Active: true}
+
+	ids, _, err := client.Groups.ListGroupSCIMIdentities(1)
+	if err != nil {
+		t.Errorf("Groups.ListGroupSCIMIdentities returned error: %v", err)
+	}
+	if !reflect.DeepEqual([]*GroupSCIMIdentity{want}, ids) {
+		t.Errorf("Groups.ListGroupSCIMIdentities returned %+v, want %+v", ids, want)
+	}
+
+	id, _, err := client.Groups.GetGroupSCIMIdentity(1, "be20d8dcc028677c931e04f387")
+	if err != nil {
+		t.Errorf("Groups.GetGroupSCIMIdentity returned error: %v", err)
+	}
+	if !reflect.DeepEqual(want, id) {
+		t.Errorf("Groups.GetGroupSCIMIdentity returned %+v, want %+v", id, want)
+	}
+
+	opt := &UpdateGroupSCIMIdentityOptions{ExternUID: String("yrnZW46BrtBFqM7xDzE7dddd")}
+	if _, err := client.Groups.UpdateGroupSCIMIdentity(1, "be20d8dcc028677c931e04f387", opt); err != nil {
+		t.Errorf("Groups.UpdateGroupSCIMIdentity returned error: %v", err)
+	}
+
+	if _, err := client.Groups.DeleteGroupSCIMIdentity(1, "be20d8dcc028677c931e04f387"); err != nil {
+		t.Errorf("Groups.DeleteGroupSCIMIdentity returned error: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportService_ImportRepositoryFromGitHub(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/import/github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"personal_access_token":"token","repo_id":1234,"new_name":"new-project","target_namespace":"group/subgroup"}`)
+		fmt.Fprint(w, `
+			{
+			  "id": 27,
+			  "name": "my-repo",
+			  "full_path": "/root/my-repo",
+			  "full_name": "Administrator / my-repo",
+			  "refs_url": "/root/my-repo/refs",
+			  "import_source": "my-github/repo",
+			  "import_status": "scheduled",
+			  "human_import_status_name": "scheduled",
+			  "provider_link": "/my-github/repo",
+			  "relation_type": null,
+			  "import_warning": null
+			}
+		`)
+	})
+
+	want := &GitHubImport{
+		ID:                    27,
+		Name:                  "my-repo",
+		FullPath:              "/root/my-repo",
+		FullName:              "Administrator / my-repo",
+		RefsURL:               "/root/my-repo/refs",
+		ImportSource:          "my-github/repo",
+		ImportStatus:          "scheduled",
+		HumanImportStatusName: "scheduled",
+		ProviderLink:          "/my-github/repo",
+	}
+
+	opt := &ImportRepositoryFromGitHubOptions{
+		PersonalAccessToken: String("token"),
+		RepoID:              Int(1234),
+		NewName:             String("new-project"),
+		TargetNamespace:     String("group/subgroup"),
+	}
+
+	gi, resp, err := client.Import.ImportRepositoryFromGitHub(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, gi)
+
+	gi, resp, err = client.Import.ImportRepositoryFromGitHub(opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, gi)
+}
+
+func TestImportService_ImportRepositoryFromBitbucketCloud(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/import/bitbucket", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"bitbucket_username":"bitbucket_user","bitbucket_app_password":"password","repo_path":"user/repo","target_namespace":"root"}`)
+		fmt.Fprint(w, `{"id": 28, "name": "repo", "full_path": "/root/repo", "import_source": "user/repo", "import_status": "scheduled"}`)
+	})
+
+	want := &BitbucketCloudImport{
+		ID:           28,
+		Name:         "repo",
+		FullPath:     "/root/repo",
+		ImportSource: "user/repo",
+		ImportStatus: "scheduled",
+	}
+
+	opt := &ImportRepositoryFromBitbucketCloudOptions{
+		BitbucketUsername:    String("bitbucket_user"),
+		BitbucketAppPassword: String("password"),
+		RepoPath:             String("user/repo"),
+		TargetNamespace:      String("root"),
+	}
+
+	bci, resp, err := client.Import.ImportRepositoryFromBitbucketCloud(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, bci)
+}
+
+func TestImportService_ImportRepositoryFromBitbucketServer(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/import/bitbucket_server", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"bitbucket_server_url":"https://bitbucket.example.com","bitbucket_server_username":"user","personal_access_token":"token","bitbucket_server_project":"PRJ","bitbucket_server_repo":"repo"}`)
+		fmt.Fprint(w, `{"id": 29, "name": "repo", "full_path": "/root/repo", "full_name": "Administrator / repo", "refs_url": "/root/repo/refs"}`)
+	})
+
+	want := &BitbucketServerImport{
+		ID:       29,
+		Name:     "repo",
+		FullPath: "/root/repo",
+		FullName: "Administrator / repo",
+		RefsURL:  "/root/repo/refs",
+	}
+
+	opt := &ImportRepositoryFromBitbucketServerOptions{
+		BitbucketServerURL:      String("https://bitbucket.example.com"),
+		BitbucketServerUsername: String("user"),
+		PersonalAccessToken:     String("token"),
+		BitbucketServerProject:  String("PRJ"),
+		BitbucketServerRepo:     String("repo"),
+	}
+
+	bsi, resp, err := client.Import.ImportRepositoryFromBitbucketServer(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, bsi)
+
+	bsi, resp, err = client.Import.ImportRepositoryFromBitbucketServer(opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, bsi)
+}
@@ -218,11 +218,13 @@ type ListIssuesOptions struct {
 	AuthorID            *int             `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername      *string          `url:"author_username,omitempty" json:"author_username,omitempty"`
 	NotAuthorUsername   *string          `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	OrAuthorUsername    *[]string        `url:"or[author_username],brackets,omitempty" json:"or[author_username],omitempty"`
 	NotAuthorID         *[]int           `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
+	OrAssigneeUsername  *[]string        `url:"or[assignee_username],brackets,omitempty" json:"or[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
 	IIDs                *[]int           `url:"iids[],omitempty" json:"iids,omitempty"`
@@ -266,23 +268,25 @@ func (s *IssuesService) ListIssues(opt *ListIssuesOptions, options ...RequestOpt
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#list-group-issues
 type ListGroupIssuesOptions struct {
 	ListOptions
-	State             *string `url:"state,omitempty" json:"state,omitempty"`
-	Labels            *Labels `url:"labels,comma,omitempty" json:"labels,omitempty"`
-	NotLabels         *Labels `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
-	WithLabelDetails  *bool   `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
-	IIDs              *[]int  `url:"iids[],omitempty" json:"iids,omitempty"`
-	Milestone         *string `url:"milestone,omitempty" json:"milestone,omitempty"`
-	NotMilestone      *string `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
-	Scope             *string `url:"scope,omitempty" json:"scope,omitempty"`
-	AuthorID          *int    `url:"author_id,omitempty" json:"author_id,omitempty"`
-	NotAuthorID       *[]int  `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
-	AuthorUsername    *string `url:"author_username,omitempty" json:"author_username,omitempty"`
-	NotAuthorUsername *string `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	State             *string   `url:"state,omitempty" json:"state,omitempty"`
+	Labels            *Labels   `url:"labels,comma,omitempty" json:"labels,omitempty"`
+	NotLabels         *Labels   `url:"not[labels],comma,omitempty" json:"not[labels],omitempty"`
+	WithLabelDetails  *bool     `url:"with_labels_details,omitempty" json:"with_labels_details,omitempty"`
+	IIDs              *[]int    `url:"iids[],omitempty" json:"iids,omitempty"`
+	Milestone         *string   `url:"milestone,omitempty" json:"milestone,omitempty"`
+	NotMilestone      *string   `url:"not[milestone],omitempty" json:"not[milestone],omitempty"`
+	Scope             *string   `url:"scope,omitempty" json:"scope,omitempty"`
+	AuthorID          *int      `url:"author_id,omitempty" json:"author_id,omitempty"`
+	NotAuthorID       *[]int    `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
+	AuthorUsername    *string   `url:"author_username,omitempty" json:"author_username,omitempty"`
+	NotAuthorUsername *string   `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	OrAuthorUsername  *[]string `url:"or[author_username],brackets,omitempty" json:"or[author_username],omitempty"`
 
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
+	OrAssigneeUsername  *[]string        `url:"or[assignee_username],brackets,omitempty" json:"or[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
 	OrderBy             *string          `url:"order_by,omitempty" json:"order_by,omitempty"`
@@ -341,11 +345,13 @@ type ListProjectIssuesOptions struct {
 	AuthorID            *int             `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername      *string          `url:"author_username,omitempty" json:"author_username,omitempty"`
 	NotAuthorUsername   *string          `url:"not[author_username],omitempty" json:"not[author_username],omitempty"`
+	OrAuthorUsername    *[]string        `url:"or[author_username],brackets,omitempty" json:"or[author_username],omitempty"`
 	NotAuthorID         *[]int           `url:"not[author_id],omitempty" json:"not[author_id],omitempty"`
 	AssigneeID          *AssigneeIDValue `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 	NotAssigneeID       *[]int           `url:"not[assignee_id],omitempty" json:"not[assignee_id],omitempty"`
 	AssigneeUsername    *string          `url:"assignee_username,omitempty" json:"assignee_username,omitempty"`
 	NotAssigneeUsername *string          `url:"not[assignee_username],omitempty" json:"not[assignee_username],omitempty"`
+	OrAssigneeUsername  *[]string        `url:"or[assignee_username],brackets,omitempty" json:"or[assignee_username],omitempty"`
 	MyReactionEmoji     *string          `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	NotMyReactionEmoji  *[]string        `url:"not[my_reaction_emoji],omitempty" json:"not[my_reaction_emoji],omitempty"`
 	OrderBy             *string          `url:"order_by,omitempty" json:"order_by,omitempty"`
@@ -520,6 +526,36 @@ func (s *IssuesService) UpdateIssue(pid interface{}, issue int, opt *UpdateIssue
 	return i, resp, nil
 }
 
+// AddIssueLabels adds labels to an issue without touching the labels that
+// are already set, and returns the resulting labels.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issue
+func (s *IssuesService) AddIssueLabels(pid interface{}, issue int, labels Labels, options ...RequestOptionFunc) (Labels, *Response, error) {
+	opt := &UpdateIssueOptions{AddLabels: &labels}
+
+	i, resp, err := s.UpdateIssue(pid, issue, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.Labels, resp, nil
+}
+
+// RemoveIssueLabels removes labels from an issue without touching any other
+// labels, and returns the resulting labels.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#edit-issue
+func (s *IssuesService) RemoveIssueLabels(pid interface{}, issue int, labels Labels, options ...RequestOptionFunc) (Labels, *Response, error) {
+	opt := &UpdateIssueOptions{RemoveLabels: &labels}
+
+	i, resp, err := s.UpdateIssue(pid, issue, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.Labels, resp, nil
+}
+
 // DeleteIssue deletes a single project issue.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#delete-an-issue
@@ -195,6 +195,34 @@ func TestListIssues(t *testing.T) {
 	}
 }
 
+func TestListIssuesNotAndOrFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/issues?not%5Blabels%5D=wontfix%2Cduplicate&not%5Bmilestone%5D=v1.0&"+
+			"or%5Bassignee_username%5D%5B%5D=carol&or%5Bauthor_username%5D%5B%5D=alice&or%5Bauthor_username%5D%5B%5D=bob")
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	opt := &ListIssuesOptions{
+		NotLabels:          &Labels{"wontfix", "duplicate"},
+		NotMilestone:       String("v1.0"),
+		OrAuthorUsername:   &[]string{"alice", "bob"},
+		OrAssigneeUsername: &[]string{"carol"},
+	}
+
+	issues, _, err := client.Issues.ListIssues(opt)
+	if err != nil {
+		t.Fatalf("Issues.ListIssues returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 1}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("Issues.ListIssues returned %+v, want %+v", issues, want)
+	}
+}
+
 func TestListIssuesWithLabelDetails(t *testing.T) {
 	mux, client := setup(t)
 
@@ -623,6 +651,46 @@ func TestUpdateIssue(t *testing.T) {
 	}
 }
 
+func TestAddIssueLabels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"add_labels":"bug,triaged"}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 5, "labels": ["backend", "bug", "triaged"]}`)
+	})
+
+	labels, _, err := client.Issues.AddIssueLabels(1, 5, Labels{"bug", "triaged"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := Labels{"backend", "bug", "triaged"}
+	if !reflect.DeepEqual(want, labels) {
+		t.Errorf("Issues.AddIssueLabels returned %+v, want %+v", labels, want)
+	}
+}
+
+func TestRemoveIssueLabels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"remove_labels":"triaged"}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 5, "labels": ["backend", "bug"]}`)
+	})
+
+	labels, _, err := client.Issues.RemoveIssueLabels(1, 5, Labels{"triaged"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	want := Labels{"backend", "bug"}
+	if !reflect.DeepEqual(want, labels) {
+		t.Errorf("Issues.RemoveIssueLabels returned %+v, want %+v", labels, want)
+	}
+}
+
 func TestSubscribeToIssue(t *testing.T) {
 	mux, client := setup(t)
 
@@ -342,8 +342,8 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 	return bytes.NewReader(artifactBuf.Bytes()), resp, err
 }
 
-// DownloadSingleArtifactsFile download a single artifact file for a specific
-// job of the latest successful pipeline for the given reference name from
+// DownloadSingleArtifactsFileByTagOrBranch downloads a single artifact file
+// for a specific job of the latest successful pipeline for the given reference name from
 // inside the job’s artifacts archive. The file is extracted from the archive
 // and streamed to the client.
 //
@@ -564,3 +564,24 @@ func (s *JobsService) DeleteArtifacts(pid interface{}, jobID int, options ...Req
 
 	return s.client.Do(req, nil)
 }
+
+// DeleteProjectArtifacts deletes artifacts eligible for deletion in a project.
+// GitLab schedules the deletion in the background and responds with 202
+// Accepted.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/job_artifacts.html#delete-project-artifacts
+func (s *JobsService) DeleteProjectArtifacts(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/artifacts", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
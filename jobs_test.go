@@ -189,6 +189,29 @@ func TestJobsService_ListProjectJobs(t *testing.T) {
 	assert.Equal(t, want, jobs)
 }
 
+func TestDownloadSingleArtifactsFile(t *testing.T) {
+	mux, client := setup(t)
+
+	wantContent := []byte("<testsuite/>")
+	mux.HandleFunc("/api/v4/projects/9/jobs/8/artifacts/reports/junit.xml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write(wantContent)
+	})
+
+	reader, _, err := client.Jobs.DownloadSingleArtifactsFile(9, 8, "reports/junit.xml")
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFile returns an error: %v", err)
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFile error reading: %v", err)
+	}
+	if !reflect.DeepEqual(content, wantContent) {
+		t.Errorf("Jobs.DownloadSingleArtifactsFile returned %+v, want %+v", content, wantContent)
+	}
+}
+
 func TestDownloadSingleArtifactsFileByTagOrBranch(t *testing.T) {
 	mux, client := setup(t)
 
@@ -218,3 +241,58 @@ func TestDownloadSingleArtifactsFileByTagOrBranch(t *testing.T) {
 		t.Errorf("Jobs.DownloadSingleArtifactsFileByTagOrBranch returned returned status code  %+v, want %+v", resp.StatusCode, wantCode)
 	}
 }
+
+func TestKeepArtifacts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/9/jobs/8/artifacts/keep", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 8, "name": "rubocop", "status": "success"}`)
+	})
+
+	job, _, err := client.Jobs.KeepArtifacts(9, 8)
+	if err != nil {
+		t.Fatalf("Jobs.KeepArtifacts returns an error: %v", err)
+	}
+
+	want := &Job{ID: 8, Name: "rubocop", Status: "success"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.KeepArtifacts returned %+v, want %+v", job, want)
+	}
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/9/jobs/8/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Jobs.DeleteArtifacts(9, 8)
+	if err != nil {
+		t.Fatalf("Jobs.DeleteArtifacts returns an error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Jobs.DeleteArtifacts returned status code %+v, want %+v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDeleteProjectArtifacts(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/9/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.Jobs.DeleteProjectArtifacts(9)
+	if err != nil {
+		t.Fatalf("Jobs.DeleteProjectArtifacts returns an error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Jobs.DeleteProjectArtifacts returned status code %+v, want %+v", resp.StatusCode, http.StatusAccepted)
+	}
+}
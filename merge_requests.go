@@ -278,6 +278,8 @@ type ListGroupMergeRequestsOptions struct {
 	CreatedBefore          *time.Time        `url:"created_before,omitempty" json:"created_before,omitempty"`
 	UpdatedAfter           *time.Time        `url:"updated_after,omitempty" json:"updated_after,omitempty"`
 	UpdatedBefore          *time.Time        `url:"updated_before,omitempty" json:"updated_before,omitempty"`
+	DeployedAfter          *time.Time        `url:"deployed_after,omitempty" json:"deployed_after,omitempty"`
+	DeployedBefore         *time.Time        `url:"deployed_before,omitempty" json:"deployed_before,omitempty"`
 	Scope                  *string           `url:"scope,omitempty" json:"scope,omitempty"`
 	AuthorID               *int              `url:"author_id,omitempty" json:"author_id,omitempty"`
 	AuthorUsername         *string           `url:"author_username,omitempty" json:"author_username,omitempty"`
@@ -286,6 +288,7 @@ type ListGroupMergeRequestsOptions struct {
 	ApprovedByIDs          *ApproverIDsValue `url:"approved_by_ids,omitempty" json:"approved_by_ids,omitempty"`
 	ReviewerID             *ReviewerIDValue  `url:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
 	ReviewerUsername       *string           `url:"reviewer_username,omitempty" json:"reviewer_username,omitempty"`
+	MergeUserID            *int              `url:"merge_user_id,omitempty" json:"merge_user_id,omitempty"`
 	MyReactionEmoji        *string           `url:"my_reaction_emoji,omitempty" json:"my_reaction_emoji,omitempty"`
 	SourceBranch           *string           `url:"source_branch,omitempty" json:"source_branch,omitempty"`
 	TargetBranch           *string           `url:"target_branch,omitempty" json:"target_branch,omitempty"`
@@ -650,6 +653,38 @@ func (s *MergeRequestsService) UpdateMergeRequest(pid interface{}, mergeRequest
 	return m, resp, nil
 }
 
+// AddMergeRequestLabels adds labels to a merge request without touching the
+// labels that are already set, and returns the resulting labels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) AddMergeRequestLabels(pid interface{}, mergeRequest int, labels Labels, options ...RequestOptionFunc) (Labels, *Response, error) {
+	opt := &UpdateMergeRequestOptions{AddLabels: &labels}
+
+	m, resp, err := s.UpdateMergeRequest(pid, mergeRequest, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.Labels, resp, nil
+}
+
+// RemoveMergeRequestLabels removes labels from a merge request without
+// touching any other labels, and returns the resulting labels.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+func (s *MergeRequestsService) RemoveMergeRequestLabels(pid interface{}, mergeRequest int, labels Labels, options ...RequestOptionFunc) (Labels, *Response, error) {
+	opt := &UpdateMergeRequestOptions{RemoveLabels: &labels}
+
+	m, resp, err := s.UpdateMergeRequest(pid, mergeRequest, opt, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.Labels, resp, nil
+}
+
 // DeleteMergeRequest deletes a merge request.
 //
 // GitLab API docs:
@@ -202,6 +202,36 @@ func TestListProjectMergeRequests(t *testing.T) {
 	}
 }
 
+func TestListGroupMergeRequestsFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/3/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "approved_by_ids%5B%5D=5&approved_by_ids%5B%5D=6&approver_ids=Any&"+
+			"deployed_after=2023-01-01T00%3A00%3A00Z&deployed_before=2023-02-01T00%3A00%3A00Z&"+
+			"merge_user_id=8&not%5Blabels%5D=wip%2Cblocked&reviewer_id=7")
+		fmt.Fprint(w, `[{"id": 1, "iid": 2, "project_id": 3}]`)
+	})
+
+	deployedAfter := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	deployedBefore := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	opts := &ListGroupMergeRequestsOptions{
+		ApprovedByIDs:  ApproverIDs([]int{5, 6}),
+		ApproverIDs:    ApproverIDs(UserIDAny),
+		ReviewerID:     ReviewerID(7),
+		NotLabels:      &Labels{"wip", "blocked"},
+		MergeUserID:    Int(8),
+		DeployedAfter:  &deployedAfter,
+		DeployedBefore: &deployedBefore,
+	}
+
+	mergeRequests, _, err := client.MergeRequests.ListGroupMergeRequests(3, opts)
+	require.NoError(t, err)
+	require.Len(t, mergeRequests, 1)
+	require.Equal(t, 2, mergeRequests[0].IID)
+}
+
 func TestCreateMergeRequestPipeline(t *testing.T) {
 	mux, client := setup(t)
 
@@ -245,6 +275,46 @@ func TestGetMergeRequestParticipants(t *testing.T) {
 	}
 }
 
+func TestAddMergeRequestLabels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"add_labels":"bug,triaged"}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 5, "labels": ["backend", "bug", "triaged"]}`)
+	})
+
+	labels, resp, err := client.MergeRequests.AddMergeRequestLabels(1, 5, Labels{"bug", "triaged"})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, Labels{"backend", "bug", "triaged"}, labels)
+
+	labels, resp, err = client.MergeRequests.AddMergeRequestLabels(1, 5, Labels{"bug"}, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, labels)
+}
+
+func TestRemoveMergeRequestLabels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"remove_labels":"triaged"}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 5, "labels": ["backend", "bug"]}`)
+	})
+
+	labels, resp, err := client.MergeRequests.RemoveMergeRequestLabels(1, 5, Labels{"triaged"})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, Labels{"backend", "bug"}, labels)
+
+	labels, resp, err = client.MergeRequests.RemoveMergeRequestLabels(1, 5, Labels{"triaged"}, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, labels)
+}
+
 func TestGetIssuesClosedOnMerge_Jira(t *testing.T) {
 	mux, client := setup(t)
 	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/closes_issues", func(w http.ResponseWriter, r *http.Request) {
@@ -131,6 +131,15 @@ func (s *NamespacesService) GetNamespace(id interface{}, options ...RequestOptio
 	return n, resp, nil
 }
 
+// GetNamespaceByPath gets a namespace by its full path, for example
+// "group/subgroup". The path is URL-encoded before it is sent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/namespaces.html#get-namespace-by-id
+func (s *NamespacesService) GetNamespaceByPath(path string, options ...RequestOptionFunc) (*Namespace, *Response, error) {
+	return s.GetNamespace(path, options...)
+}
+
 // NamespaceExistance represents a namespace exists result.
 //
 // GitLab API docs:
@@ -157,7 +166,7 @@ func (s *NamespacesService) NamespaceExists(id interface{}, opt *NamespaceExists
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("namespaces/%s/exists", namespace)
+	u := fmt.Sprintf("namespaces/%s/exists", PathEscape(namespace))
 
 	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
 	if err != nil {
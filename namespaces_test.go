@@ -221,11 +221,47 @@ func TestGetNamespace(t *testing.T) {
 	}
 }
 
+func TestGetNamespaceByPath(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/namespaces/group1/subgroup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/namespaces/group1%2Fsubgroup")
+		fmt.Fprint(w, `{
+			"id": 3,
+			"name": "subgroup",
+			"path": "subgroup",
+			"kind": "group",
+			"full_path": "group1/subgroup",
+			"parent_id": 2
+		  }`)
+	})
+
+	namespace, _, err := client.Namespaces.GetNamespaceByPath("group1/subgroup")
+	if err != nil {
+		t.Errorf("Namespaces.GetNamespaceByPath returned error: %v", err)
+	}
+
+	want := &Namespace{
+		ID:       3,
+		Name:     "subgroup",
+		Path:     "subgroup",
+		Kind:     "group",
+		FullPath: "group1/subgroup",
+		ParentID: 2,
+	}
+
+	if !reflect.DeepEqual(namespace, want) {
+		t.Errorf("Namespaces.GetNamespaceByPath returned \ngot:\n%v\nwant:\n%v", Stringify(namespace), Stringify(want))
+	}
+}
+
 func TestNamespaceExists(t *testing.T) {
 	mux, client := setup(t)
 
 	mux.HandleFunc("/api/v4/namespaces/my-group/exists", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "parent_id=1")
 		fmt.Fprintf(w, `{
 			"exists": true,
 			"suggests": [
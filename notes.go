@@ -70,6 +70,7 @@ type Note struct {
 	} `json:"resolved_by"`
 	ResolvedAt  *time.Time `json:"resolved_at"`
 	NoteableIID int        `json:"noteable_iid"`
+	Internal    bool       `json:"internal"`
 }
 
 // NotePosition represents the position attributes of a note.
@@ -83,6 +84,10 @@ type NotePosition struct {
 	OldPath      string     `json:"old_path,omitempty"`
 	OldLine      int        `json:"old_line,omitempty"`
 	LineRange    *LineRange `json:"line_range,omitempty"`
+	Width        *int       `json:"width,omitempty"`
+	Height       *int       `json:"height,omitempty"`
+	X            *int       `json:"x,omitempty"`
+	Y            *int       `json:"y,omitempty"`
 }
 
 // LineRange represents the range of a note.
@@ -171,6 +176,7 @@ func (s *NotesService) GetIssueNote(pid interface{}, issue, note int, options ..
 type CreateIssueNoteOptions struct {
 	Body      *string    `url:"body,omitempty" json:"body,omitempty"`
 	CreatedAt *time.Time `url:"created_at,omitempty" json:"created_at,omitempty"`
+	Internal  *bool      `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
 // CreateIssueNote creates a new note to a single project issue.
@@ -467,7 +473,8 @@ func (s *NotesService) GetMergeRequestNote(pid interface{}, mergeRequest, note i
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
 type CreateMergeRequestNoteOptions struct {
-	Body *string `url:"body,omitempty" json:"body,omitempty"`
+	Body     *string `url:"body,omitempty" json:"body,omitempty"`
+	Internal *bool   `url:"internal,omitempty" json:"internal,omitempty"`
 }
 
 // CreateMergeRequestNote creates a new note for a single merge request.
@@ -78,3 +78,67 @@ func TestGetMergeRequestNote(t *testing.T) {
 		t.Errorf("Notes.GetEpicNote want %#v, got %#v", note, want)
 	}
 }
+
+func TestCreateInternalIssueNote(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/4329/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"body":"staff only","internal":true}`)
+		fmt.Fprint(w, `{"id":3,"body":"staff only","noteable_id":4392,"noteable_type":"Issue","internal":true}`)
+	})
+
+	opt := &CreateIssueNoteOptions{
+		Body:     String("staff only"),
+		Internal: Bool(true),
+	}
+
+	note, _, err := client.Notes.CreateIssueNote("1", 4329, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{
+		ID:           3,
+		Body:         "staff only",
+		NoteableID:   4392,
+		NoteableType: "Issue",
+		Internal:     true,
+	}
+
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateIssueNote want %#v, got %#v", want, note)
+	}
+}
+
+func TestCreateInternalMergeRequestNote(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/4329/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"body":"staff only","internal":true}`)
+		fmt.Fprint(w, `{"id":3,"body":"staff only","noteable_id":4392,"noteable_type":"MergeRequest","internal":true}`)
+	})
+
+	opt := &CreateMergeRequestNoteOptions{
+		Body:     String("staff only"),
+		Internal: Bool(true),
+	}
+
+	note, _, err := client.Notes.CreateMergeRequestNote("1", 4329, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Note{
+		ID:           3,
+		Body:         "staff only",
+		NoteableID:   4392,
+		NoteableType: "MergeRequest",
+		Internal:     true,
+	}
+
+	if !reflect.DeepEqual(note, want) {
+		t.Errorf("Notes.CreateMergeRequestNote want %#v, got %#v", want, note)
+	}
+}
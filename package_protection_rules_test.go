@@ -0,0 +1,136 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageProtectionRulesService_ListPackageProtectionRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=1&per_page=10")
+		fmt.Fprint(w, `[{"id": 1, "project_id": 7, "package_name_pattern": "@flight/flight-developer-*", "package_type": "npm", "minimum_access_level_for_push": "maintainer"}]`)
+	})
+
+	want := []*PackageProtectionRule{{
+		ID:                        1,
+		ProjectID:                 7,
+		PackageNamePattern:        "@flight/flight-developer-*",
+		PackageType:               "npm",
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevelMaintainer,
+	}}
+
+	rules, resp, err := client.PackageProtectionRules.ListPackageProtectionRules(7, &ListPackageProtectionRulesOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rules)
+
+	rules, resp, err = client.PackageProtectionRules.ListPackageProtectionRules(7, nil, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rules)
+}
+
+func TestPackageProtectionRulesService_CreatePackageProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"package_name_pattern":"@flight/flight-*","package_type":"npm","minimum_access_level_for_push":"owner"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "package_name_pattern": "@flight/flight-*", "package_type": "npm", "minimum_access_level_for_push": "owner"}`)
+	})
+
+	want := &PackageProtectionRule{
+		ID:                        2,
+		ProjectID:                 7,
+		PackageNamePattern:        "@flight/flight-*",
+		PackageType:               "npm",
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevelOwner,
+	}
+
+	opt := &CreatePackageProtectionRuleOptions{
+		PackageNamePattern:        String("@flight/flight-*"),
+		PackageType:               String("npm"),
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevel(ProtectionRuleAccessLevelOwner),
+	}
+
+	rule, resp, err := client.PackageProtectionRules.CreatePackageProtectionRule(7, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rule)
+
+	rule, resp, err = client.PackageProtectionRules.CreatePackageProtectionRule(7, opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rule)
+}
+
+func TestPackageProtectionRulesService_UpdatePackageProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `{"minimum_access_level_for_push":"admin"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "package_name_pattern": "@flight/flight-*", "package_type": "npm", "minimum_access_level_for_push": "admin"}`)
+	})
+
+	want := &PackageProtectionRule{
+		ID:                        2,
+		ProjectID:                 7,
+		PackageNamePattern:        "@flight/flight-*",
+		PackageType:               "npm",
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevelAdmin,
+	}
+
+	opt := &UpdatePackageProtectionRuleOptions{
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevel(ProtectionRuleAccessLevelAdmin),
+	}
+
+	rule, resp, err := client.PackageProtectionRules.UpdatePackageProtectionRule(7, 2, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rule)
+
+	rule, resp, err = client.PackageProtectionRules.UpdatePackageProtectionRule(7, 2, opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rule)
+}
+
+func TestPackageProtectionRulesService_DeletePackageProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/packages/protection/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.PackageProtectionRules.DeletePackageProtectionRule(7, 2)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	resp, err = client.PackageProtectionRules.DeletePackageProtectionRule(7, 2, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
@@ -40,3 +41,22 @@ func TestRunPipelineSchedule(t *testing.T) {
 		t.Errorf("PipelineSchedules.RunPipelineSchedule returned status %v, want %v", res.StatusCode, http.StatusCreated)
 	}
 }
+
+func TestTakeOwnershipOfPipelineSchedule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/1/take_ownership", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 1, "description": "nightly", "owner": {"id": 2, "username": "jane"}}`)
+	})
+
+	schedule, _, err := client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(1, 1)
+	if err != nil {
+		t.Errorf("PipelineSchedules.TakeOwnershipOfPipelineSchedule returned error: %v", err)
+	}
+
+	want := &PipelineSchedule{ID: 1, Description: "nightly", Owner: &User{ID: 2, Username: "jane"}}
+	if !reflect.DeepEqual(want, schedule) {
+		t.Errorf("PipelineSchedules.TakeOwnershipOfPipelineSchedule returned %+v, want %+v", schedule, want)
+	}
+}
@@ -18,12 +18,29 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+var (
+	// ErrImportFailed is returned by WaitForImport when the import ends in
+	// the failed state.
+	ErrImportFailed = errors.New("project import failed")
+
+	// ErrNoImport is returned by WaitForImport when the project has no
+	// import to wait for.
+	ErrNoImport = errors.New("project has no import")
+)
+
+// defaultImportPollInterval is used by WaitForImport when no poll interval
+// is given.
+const defaultImportPollInterval = 5 * time.Second
+
 // ProjectImportExportService handles communication with the project
 // import/export related methods of the GitLab API.
 //
@@ -84,11 +101,20 @@ func (s ExportStatus) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_import_export.html#schedule-an-export
 type ScheduleExportOptions struct {
-	Description *string `url:"description,omitempty" json:"description,omitempty"`
-	Upload      struct {
-		URL        *string `url:"url,omitempty" json:"url,omitempty"`
-		HTTPMethod *string `url:"http_method,omitempty" json:"http_method,omitempty"`
-	} `url:"upload,omitempty" json:"upload,omitempty"`
+	Description *string       `url:"description,omitempty" json:"description,omitempty"`
+	Upload      *ExportUpload `url:"upload,omitempty" json:"upload,omitempty"`
+}
+
+// ExportUpload represents the remote location a project export is uploaded
+// to once it is finished. URLVariables are added as query parameters to URL,
+// which is useful for pre-signed object storage URLs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_import_export.html#schedule-an-export
+type ExportUpload struct {
+	URL          *string           `url:"url,omitempty" json:"url,omitempty"`
+	HTTPMethod   *string           `url:"http_method,omitempty" json:"http_method,omitempty"`
+	URLVariables map[string]string `url:"-" json:"-"`
 }
 
 // ScheduleExport schedules a project export.
@@ -102,6 +128,31 @@ func (s *ProjectImportExportService) ScheduleExport(pid interface{}, opt *Schedu
 	}
 	u := fmt.Sprintf("projects/%s/export", PathEscape(project))
 
+	if opt != nil && opt.Upload != nil && opt.Upload.URL != nil && len(opt.Upload.URLVariables) > 0 {
+		uploadURL, err := url.Parse(*opt.Upload.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		// Append to the raw query instead of re-encoding it, as pre-signed
+		// URLs are signed over the exact query string.
+		extra := make(url.Values, len(opt.Upload.URLVariables))
+		for k, v := range opt.Upload.URLVariables {
+			extra.Set(k, v)
+		}
+		if uploadURL.RawQuery != "" {
+			uploadURL.RawQuery += "&"
+		}
+		uploadURL.RawQuery += extra.Encode()
+
+		// Don't modify the options passed in by the caller.
+		upload := *opt.Upload
+		upload.URL = String(uploadURL.String())
+		o := *opt
+		o.Upload = &upload
+		opt = &o
+	}
+
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
 	if err != nil {
 		return nil, err
@@ -223,3 +274,44 @@ func (s *ProjectImportExportService) ImportStatus(pid interface{}, options ...Re
 
 	return is, resp, nil
 }
+
+// WaitForImport polls the import status of a project every pollInterval
+// until the import is either finished or failed, or until ctx is done. A
+// pollInterval of zero or less defaults to 5 seconds. The last retrieved
+// import status is returned, together with ErrImportFailed if the import
+// failed or ErrNoImport if the project was never imported.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_import_export.html#import-status
+func (s *ProjectImportExportService) WaitForImport(ctx context.Context, pid interface{}, pollInterval time.Duration, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultImportPollInterval
+	}
+
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		is, resp, err := s.ImportStatus(pid, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch is.ImportStatus {
+		case "finished":
+			return is, resp, nil
+		case "failed":
+			return is, resp, ErrImportFailed
+		case "none":
+			return is, resp, ErrNoImport
+		}
+
+		select {
+		case <-ctx.Done():
+			return is, resp, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
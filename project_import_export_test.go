@@ -2,9 +2,11 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -34,6 +36,54 @@ func TestProjectImportExportService_ScheduleExport(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestProjectImportExportService_ScheduleExportWithUpload(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"upload":{"url":"https://bucket.example.com/export.tar.gz?X-Amz-Expires=3600\u0026X-Amz-Signature=abc","http_method":"PUT"}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &ScheduleExportOptions{
+		Upload: &ExportUpload{
+			URL:        String("https://bucket.example.com/export.tar.gz"),
+			HTTPMethod: String("PUT"),
+			URLVariables: map[string]string{
+				"X-Amz-Expires":   "3600",
+				"X-Amz-Signature": "abc",
+			},
+		},
+	}
+
+	resp, err := client.ProjectImportExport.ScheduleExport(1, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, "https://bucket.example.com/export.tar.gz", *opt.Upload.URL)
+}
+
+func TestProjectImportExportService_ScheduleExportWithPresignedUpload(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"upload":{"url":"https://bucket.example.com/export.tar.gz?X-Amz-SignedHeaders=host\u0026X-Amz-Credential=AKIA%2F20240101%2Fus-east-1%2Fs3%2Faws4_request\u0026X-Amz-Signature=abc","http_method":"PUT"}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &ScheduleExportOptions{
+		Upload: &ExportUpload{
+			URL:          String("https://bucket.example.com/export.tar.gz?X-Amz-SignedHeaders=host&X-Amz-Credential=AKIA%2F20240101%2Fus-east-1%2Fs3%2Faws4_request"),
+			HTTPMethod:   String("PUT"),
+			URLVariables: map[string]string{"X-Amz-Signature": "abc"},
+		},
+	}
+
+	resp, err := client.ProjectImportExport.ScheduleExport(1, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
 func TestProjectImportExportService_ExportStatus(t *testing.T) {
 	mux, client := setup(t)
 
@@ -218,3 +268,70 @@ func TestProjectImportExportService_ImportStatus(t *testing.T) {
 	require.Nil(t, es)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectImportExportService_WaitForImport(t *testing.T) {
+	mux, client := setup(t)
+
+	statuses := []string{"scheduled", "started", "finished"}
+	calls := 0
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"id": 1, "import_status": %q, "correlation_id": "abc"}`, statuses[calls])
+		calls++
+	})
+
+	is, resp, err := client.ProjectImportExport.WaitForImport(context.Background(), 1, time.Millisecond)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, &ImportStatus{ID: 1, ImportStatus: "finished", CorrelationID: "abc"}, is)
+	require.Equal(t, 3, calls)
+}
+
+func TestProjectImportExportService_WaitForImportFailed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "import_status": "failed", "import_error": "repository not found"}`)
+	})
+
+	is, _, err := client.ProjectImportExport.WaitForImport(context.Background(), 1, time.Millisecond)
+	require.ErrorIs(t, err, ErrImportFailed)
+	require.Equal(t, "failed", is.ImportStatus)
+	require.Equal(t, "repository not found", is.ImportError)
+}
+
+func TestProjectImportExportService_WaitForImportNone(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "import_status": "none"}`)
+	})
+
+	is, _, err := client.ProjectImportExport.WaitForImport(context.Background(), 1, 0)
+	require.ErrorIs(t, err, ErrNoImport)
+	require.Equal(t, "none", is.ImportStatus)
+}
+
+func TestProjectImportExportService_WaitForImportContextDone(t *testing.T) {
+	mux, client := setup(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "import_status": "started"}`)
+	})
+
+	is, _, err := client.ProjectImportExport.WaitForImport(ctx, 1, time.Hour)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, "started", is.ImportStatus)
+
+	is, resp, err := client.ProjectImportExport.WaitForImport(context.Background(), 1.01, time.Hour)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, is)
+}
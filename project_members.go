@@ -94,6 +94,39 @@ func (s *ProjectMembersService) ListAllProjectMembers(pid interface{}, opt *List
 	return pm, resp, nil
 }
 
+// GetEffectiveAccess returns the effective access level of every member of
+// a project, keyed by user ID. It walks all pages of ListAllProjectMembers()
+// and, for users that are members through more than one path (directly,
+// through an ancestor group or through a shared group), keeps the highest
+// access level.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members
+func (s *ProjectMembersService) GetEffectiveAccess(pid interface{}, options ...RequestOptionFunc) (map[int]AccessLevelValue, *Response, error) {
+	opt := &ListProjectMembersOptions{
+		ListOptions: ListOptions{PerPage: 100},
+	}
+
+	access := make(map[int]AccessLevelValue)
+	for {
+		pm, resp, err := s.ListAllProjectMembers(pid, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, m := range pm {
+			if level, ok := access[m.ID]; !ok || m.AccessLevel > level {
+				access[m.ID] = m.AccessLevel
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return access, resp, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
 // GetProjectMember gets a project team member.
 //
 // GitLab API docs:
@@ -114,6 +114,51 @@ func TestProjectMembersService_ListAllProjectMembers(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestProjectMembersService_GetEffectiveAccess(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			testParams(t, r, "per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "access_level": 30}, {"id": 2, "access_level": 20}]`)
+		case "2":
+			testParams(t, r, "page=2&per_page=100")
+			fmt.Fprint(w, `[{"id": 1, "access_level": 40}, {"id": 2, "access_level": 10}, {"id": 3, "access_level": 50}]`)
+		default:
+			t.Fatalf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	want := map[int]AccessLevelValue{
+		1: MaintainerPermissions,
+		2: ReporterPermissions,
+		3: OwnerPermissions,
+	}
+
+	access, resp, err := client.ProjectMembers.GetEffectiveAccess(1)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, access)
+
+	access, resp, err = client.ProjectMembers.GetEffectiveAccess(1.01)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, access)
+
+	access, resp, err = client.ProjectMembers.GetEffectiveAccess(1, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, access)
+
+	access, resp, err = client.ProjectMembers.GetEffectiveAccess(2)
+	require.Error(t, err)
+	require.Nil(t, access)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func TestProjectMembersService_GetProjectMember(t *testing.T) {
 	mux, client := setup(t)
 
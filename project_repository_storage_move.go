@@ -17,11 +17,24 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
+// ErrStorageMoveFailed is returned when a repository storage move that is
+// being waited on ends in the failed or cleanup failed state.
+var ErrStorageMoveFailed = errors.New("repository storage move failed")
+
+// defaultStorageMovePollInterval is used when WaitOptions.PollInterval is
+// not set.
+const defaultStorageMovePollInterval = 5 * time.Second
+
 // ProjectRepositoryStorageMoveService handles communication with the
 // repositories related methods of the GitLab API.
 //
@@ -31,35 +44,122 @@ type ProjectRepositoryStorageMoveService struct {
 	client *Client
 }
 
+// ProjectRepositoryStorageMoveServiceInterface is the method set of
+// ProjectRepositoryStorageMoveService. Client.ProjectRepositoryStorageMove is
+// of this type, so it can be replaced by a fake in unit tests.
+type ProjectRepositoryStorageMoveServiceInterface interface {
+	RetrieveAllStorageMoves(opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error)
+	RetrieveStorageMovesByState(state StorageMoveState, opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error)
+	RetrieveAllStorageMovesForProject(project int, opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error)
+	GetStorageMove(repositoryStorage int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error)
+	GetStorageMoveForProject(project int, repositoryStorage int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error)
+	GetStorageMoves(ctx context.Context, ids []int, concurrency int, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, error)
+	WaitForStorageMove(project, moveID int, opts WaitOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, error)
+	WaitForAllStorageMoves(ctx context.Context, moveIDs []int, pollInterval time.Duration, options ...RequestOptionFunc) (map[int]*ProjectRepositoryStorageMove, error)
+	DrainShard(ctx context.Context, sourceStorage string, opts DrainOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, error)
+	ScheduleAllStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...RequestOptionFunc) (*Response, error)
+	ScheduleStorageMoveForProject(project int, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error)
+	ScheduleStorageMoveForProjectOne(project int, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error)
+}
+
+var _ ProjectRepositoryStorageMoveServiceInterface = (*ProjectRepositoryStorageMoveService)(nil)
+
 // ProjectRepositoryStorageMove represents the status of a repository move.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
 type ProjectRepositoryStorageMove struct {
-	ID                     int        `json:"id"`
-	CreatedAt              *time.Time `json:"created_at"`
-	State                  string     `json:"state"`
-	SourceStorageName      string     `json:"source_storage_name"`
-	DestinationStorageName string     `json:"destination_storage_name"`
-	Project                struct {
-		ID                int        `json:"id"`
-		Description       string     `json:"description"`
-		Name              string     `json:"name"`
-		NameWithNamespace string     `json:"name_with_namespace"`
-		Path              string     `json:"path"`
-		PathWithNamespace string     `json:"path_with_namespace"`
-		CreatedAt         *time.Time `json:"created_at"`
-	} `json:"project"`
+	ID                     int                `json:"id"`
+	CreatedAt              *time.Time         `json:"created_at"`
+	State                  StorageMoveState   `json:"state"`
+	SourceStorageName      string             `json:"source_storage_name"`
+	DestinationStorageName string             `json:"destination_storage_name"`
+	Project                StorageMoveProject `json:"project"`
+}
+
+// StorageMoveState represents the state of a repository storage move. Since
+// it is a string type, states added by future GitLab versions are decoded
+// as is.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type StorageMoveState string
+
+// List of available repository storage move states.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+const (
+	StorageMoveInitial       StorageMoveState = "initial"
+	StorageMoveScheduled     StorageMoveState = "scheduled"
+	StorageMoveStarted       StorageMoveState = "started"
+	StorageMoveReplicated    StorageMoveState = "replicated"
+	StorageMoveFinished      StorageMoveState = "finished"
+	StorageMoveFailed        StorageMoveState = "failed"
+	StorageMoveCleanupFailed StorageMoveState = "cleanup failed"
+)
+
+// IsTerminal reports whether the move has reached a state it won't leave
+// anymore, which is either finished, failed or cleanup failed.
+func (m *ProjectRepositoryStorageMove) IsTerminal() bool {
+	switch m.State {
+	case StorageMoveFinished, StorageMoveFailed, StorageMoveCleanupFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// FilterByState returns the moves that are in one of the given states, in
+// their original order.
+func FilterByState(moves []*ProjectRepositoryStorageMove, states ...StorageMoveState) []*ProjectRepositoryStorageMove {
+	var matches []*ProjectRepositoryStorageMove
+	for _, m := range moves {
+		for _, state := range states {
+			if m.State == state {
+				matches = append(matches, m)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// StorageMoveProject represents the project summary embedded in a
+// ProjectRepositoryStorageMove.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type StorageMoveProject struct {
+	ID                int        `json:"id"`
+	Description       string     `json:"description"`
+	Name              string     `json:"name"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	Path              string     `json:"path"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+	CreatedAt         *time.Time `json:"created_at"`
 }
 
 // RetrieveAllStorageMovesOptions represents the available
 // RetrieveAllStorageMoves() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
-type RetrieveAllStorageMovesOptions ListOptions
+type RetrieveAllStorageMovesOptions struct {
+	ListOptions
+	SourceStorageName *string `url:"source_storage_name,omitempty" json:"source_storage_name,omitempty"`
+	OrderBy           *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort              *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
 
 // RetrieveAllStorageMoves retrieves all repository storage moves accessible by
-// the authenticated user.
+// the authenticated user. SourceStorageName is only honoured by GitLab
+// versions that support filtering by source storage.
+//
+// RetrieveAllStorageMovesOptions used to be a plain ListOptions. Callers that
+// set pagination fields in a composite literal now need to wrap them, so
+// RetrieveAllStorageMovesOptions{Page: 1} becomes
+// RetrieveAllStorageMovesOptions{ListOptions: ListOptions{Page: 1}}. Setting
+// fields directly, as in opts.Page = 1, keeps working.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
@@ -78,6 +178,46 @@ func (s ProjectRepositoryStorageMoveService) RetrieveAllStorageMoves(opts Retrie
 	return psms, resp, err
 }
 
+// RetrieveStorageMovesByState retrieves all repository storage moves
+// accessible by the authenticated user that are in the given state, for
+// example StorageMoveScheduled or StorageMoveFailed. The API has no state filter, so
+// this walks all pages starting at opts and filters on the client side.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
+func (s ProjectRepositoryStorageMoveService) RetrieveStorageMovesByState(state StorageMoveState, opts RetrieveAllStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	return s.retrieveMatchingStorageMoves(opts, func(psm *ProjectRepositoryStorageMove) bool {
+		return psm.State == state
+	}, options)
+}
+
+// retrieveMatchingStorageMoves walks all pages of repository storage moves
+// starting at opts and returns the moves for which match returns true.
+func (s ProjectRepositoryStorageMoveService) retrieveMatchingStorageMoves(opts RetrieveAllStorageMovesOptions, match func(*ProjectRepositoryStorageMove) bool, options []RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	var matches []*ProjectRepositoryStorageMove
+	for {
+		psms, resp, err := s.RetrieveAllStorageMoves(opts, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, psm := range psms {
+			if match(psm) {
+				matches = append(matches, psm)
+			}
+		}
+
+		switch {
+		case resp.NextPage != 0:
+			opts.Page = resp.NextPage
+		case resp.Cursor != "":
+			opts.Cursor = resp.Cursor
+		default:
+			return matches, resp, nil
+		}
+	}
+}
+
 // RetrieveAllStorageMovesForProject retrieves all repository storage moves for
 // a single project accessible by the authenticated user.
 //
@@ -112,13 +252,7 @@ func (s ProjectRepositoryStorageMoveService) GetStorageMove(repositoryStorage in
 		return nil, nil, err
 	}
 
-	psm := new(ProjectRepositoryStorageMove)
-	resp, err := s.client.Do(req, psm)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return psm, resp, err
+	return Do[ProjectRepositoryStorageMove](s.client, req)
 }
 
 // GetStorageMoveForProject gets a single repository storage move for a project.
@@ -133,42 +267,382 @@ func (s ProjectRepositoryStorageMoveService) GetStorageMoveForProject(project in
 		return nil, nil, err
 	}
 
-	psm := new(ProjectRepositoryStorageMove)
-	resp, err := s.client.Do(req, psm)
-	if err != nil {
-		return nil, resp, err
+	return Do[ProjectRepositoryStorageMove](s.client, req)
+}
+
+// WaitOptions represents the available WaitForStorageMove() options.
+type WaitOptions struct {
+	// Context is used to cancel the wait. Defaults to context.Background().
+	Context context.Context
+
+	// PollInterval is the time between two status checks. Defaults to
+	// 5 seconds.
+	PollInterval time.Duration
+
+	// Timeout is the maximum time to wait. Zero means no limit.
+	Timeout time.Duration
+}
+
+// WaitForStorageMove polls a repository storage move for a project until it
+// is either finished or failed, or until the context or timeout from opts
+// expires. The last retrieved move is returned, together with
+// ErrStorageMoveFailed if the move failed.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-repository-storage-move-for-a-project
+func (s ProjectRepositoryStorageMoveService) WaitForStorageMove(project, moveID int, opts WaitOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultStorageMovePollInterval
+	}
+
+	return waitForStorageMove(ctx, pollInterval, func(options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+		return s.GetStorageMoveForProject(project, moveID, options...)
+	}, options)
+}
+
+// StorageMovesError is returned by GetStorageMoves, WaitForAllStorageMoves
+// and DrainShard when one or more of the repository storage moves failed or
+// could not be retrieved. Errors is keyed by repository storage move ID.
+type StorageMovesError struct {
+	Errors map[int]error
+}
+
+func (e *StorageMovesError) Error() string {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	msgs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("move %d: %v", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("%d repository storage moves failed: %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// Is reports whether any of the underlying errors matches target, so
+// errors.Is(err, ErrStorageMoveFailed) works on the combined error.
+func (e *StorageMovesError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStorageMoves gets the repository storage moves with the given IDs, with
+// at most concurrency moves being retrieved at the same time. The moves are
+// returned in the same order as ids. A move that could not be retrieved is
+// left nil and reported in the returned *StorageMovesError, without dropping
+// the moves that were retrieved. Once ctx is done, in-flight requests are
+// cancelled and no new ones are started.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-project-repository-storage-move
+func (s ProjectRepositoryStorageMoveService) GetStorageMoves(ctx context.Context, ids []int, concurrency int, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, error) {
+	if concurrency <= 0 {
+		concurrency = maxConcurrentStorageMoveWaits
+	}
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		indexes = make(chan int)
+		psms    = make([]*ProjectRepositoryStorageMove, len(ids))
+		errs    = make(map[int]error)
+	)
+
+	for i := 0; i < concurrency && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				psm, _, err := s.GetStorageMove(ids[i], options...)
+				if err != nil {
+					mu.Lock()
+					errs[ids[i]] = err
+					mu.Unlock()
+					continue
+				}
+				psms[i] = psm
+			}
+		}()
+	}
+
+	for i, id := range ids {
+		if ctx.Err() != nil {
+			// The remaining moves are never requested.
+			mu.Lock()
+			errs[id] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[id] = ctx.Err()
+			mu.Unlock()
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return psms, &StorageMovesError{Errors: errs}
+	}
+	return psms, nil
+}
+
+// maxConcurrentStorageMoveWaits limits the number of repository storage
+// moves WaitForAllStorageMoves polls at the same time, and is the default
+// for DrainOptions.MaxConcurrentPolls.
+const maxConcurrentStorageMoveWaits = 10
+
+// WaitForAllStorageMoves polls the given repository storage moves every
+// pollInterval until each of them is either finished or failed, or until ctx
+// is done. It returns the last retrieved move for every ID that could be
+// retrieved, and a *StorageMovesError for all moves that failed or could not
+// be waited on.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-project-repository-storage-move
+func (s ProjectRepositoryStorageMoveService) WaitForAllStorageMoves(ctx context.Context, moveIDs []int, pollInterval time.Duration, options ...RequestOptionFunc) (map[int]*ProjectRepositoryStorageMove, error) {
+	psms, errs := s.waitForStorageMoves(ctx, moveIDs, nil, pollInterval, maxConcurrentStorageMoveWaits, nil, options)
+	if len(errs) > 0 {
+		return psms, &StorageMovesError{Errors: errs}
 	}
+	return psms, nil
+}
+
+// DrainOptions represents the available DrainShard() options.
+type DrainOptions struct {
+	// DestinationStorageName is the storage shard the repositories are moved
+	// to. If not set, GitLab picks a destination based on storage weights.
+	DestinationStorageName *string
 
-	return psm, resp, err
+	// PollInterval is the time between two status checks of a single move.
+	// Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// MaxConcurrentPolls is the maximum number of moves that are polled at
+	// the same time. Defaults to 10.
+	MaxConcurrentPolls int
+
+	// OnStateChange, if set, is called every time a polled move is found in
+	// a different state than before. Calls are never made concurrently.
+	OnStateChange func(move *ProjectRepositoryStorageMove, previousState StorageMoveState)
 }
 
-// ScheduleAllStorageMoves schedules all repositories to be moved.
+// DrainShard schedules moves for all project repositories on sourceStorage
+// and waits until each of them is either finished or failed, or until ctx is
+// done. Failed moves do not stop the others from being waited on.
+//
+// GitLab does not return the moves it scheduled, so after scheduling they are
+// looked up by listing all moves off sourceStorage that have not yet reached
+// a terminal state. Moves that finish before they are listed are therefore
+// not included. The final state of every move is returned, together with a
+// *StorageMovesError for all moves that failed or could not be waited on.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
-func (s ProjectRepositoryStorageMoveService) ScheduleAllStorageMoves(options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodPost, "project_repository_storage_moves", nil, options)
+func (s ProjectRepositoryStorageMoveService) DrainShard(ctx context.Context, sourceStorage string, opts DrainOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, error) {
+	scheduleOpts := ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String(sourceStorage),
+		DestinationStorageName: opts.DestinationStorageName,
+	}
+	ctxOptions := append(options[:len(options):len(options)], WithContext(ctx))
+	if _, err := s.ScheduleAllStorageMoves(&scheduleOpts, ctxOptions...); err != nil {
+		return nil, err
+	}
+
+	listOpts := RetrieveAllStorageMovesOptions{SourceStorageName: String(sourceStorage)}
+	scheduled, _, err := s.retrieveMatchingStorageMoves(listOpts, func(psm *ProjectRepositoryStorageMove) bool {
+		// Older GitLab versions ignore the source storage filter.
+		return psm.SourceStorageName == sourceStorage && !psm.IsTerminal()
+	}, ctxOptions)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	var psms []*ProjectRepositoryStorageMove
-	resp, err := s.client.Do(req, &psms)
+	concurrency := opts.MaxConcurrentPolls
+	if concurrency <= 0 {
+		concurrency = maxConcurrentStorageMoveWaits
+	}
+
+	moveIDs := make([]int, 0, len(scheduled))
+	states := make(map[int]StorageMoveState, len(scheduled))
+	for _, psm := range scheduled {
+		moveIDs = append(moveIDs, psm.ID)
+		states[psm.ID] = psm.State
+	}
+
+	final, errs := s.waitForStorageMoves(ctx, moveIDs, states, opts.PollInterval, concurrency, opts.OnStateChange, options)
+
+	psms := make([]*ProjectRepositoryStorageMove, 0, len(scheduled))
+	for _, psm := range scheduled {
+		if f, ok := final[psm.ID]; ok {
+			psm = f
+		}
+		psms = append(psms, psm)
+	}
+
+	if len(errs) > 0 {
+		return psms, &StorageMovesError{Errors: errs}
+	}
+	return psms, nil
+}
+
+// waitForStorageMoves waits for the given moves with at most concurrency
+// moves being polled at the same time. The optional states hold the last
+// known state of each move, which onStateChange, if set, receives as the
+// previous state for the first poll.
+func (s ProjectRepositoryStorageMoveService) waitForStorageMoves(ctx context.Context, moveIDs []int, states map[int]StorageMoveState, pollInterval time.Duration, concurrency int, onStateChange func(*ProjectRepositoryStorageMove, StorageMoveState), options []RequestOptionFunc) (map[int]*ProjectRepositoryStorageMove, map[int]error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultStorageMovePollInterval
+	}
+
+	var (
+		mu   sync.Mutex
+		cbMu sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+		psms = make(map[int]*ProjectRepositoryStorageMove, len(moveIDs))
+		errs = make(map[int]error)
+	)
+
+	for _, id := range moveIDs {
+		wg.Add(1)
+		go func(id int, state StorageMoveState) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			psm, err := waitForStorageMove(ctx, pollInterval, func(options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+				psm, resp, err := s.GetStorageMove(id, options...)
+				if err == nil && onStateChange != nil && psm.State != state {
+					cbMu.Lock()
+					onStateChange(psm, state)
+					cbMu.Unlock()
+					state = psm.State
+				}
+				return psm, resp, err
+			}, options)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if psm != nil {
+				psms[id] = psm
+			}
+			if err != nil {
+				errs[id] = err
+			}
+		}(id, states[id])
+	}
+	wg.Wait()
+
+	return psms, errs
+}
+
+// waitForStorageMove calls get every pollInterval until the returned move is
+// either finished or failed, or until ctx is done.
+func waitForStorageMove(ctx context.Context, pollInterval time.Duration, get func(options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error), options []RequestOptionFunc) (*ProjectRepositoryStorageMove, error) {
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		psm, _, err := get(options...)
+		if err != nil {
+			return nil, err
+		}
+
+		if psm.IsTerminal() {
+			if psm.State != StorageMoveFinished {
+				return psm, ErrStorageMoveFailed
+			}
+			return psm, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return psm, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScheduleAllStorageMovesOptions represents the available
+// ScheduleAllStorageMoves() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+type ScheduleAllStorageMovesOptions struct {
+	SourceStorageName      *string `url:"source_storage_name,omitempty" json:"source_storage_name,omitempty"`
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleAllStorageMoves schedules all repositories to be moved. If opt is
+// nil, no body is sent and GitLab picks the source and destination storages.
+// GitLab schedules the moves in the background and only acknowledges the
+// request, so use RetrieveAllStorageMoves to find the scheduled moves.
+//
+// This method used to take no options and to return a list of moves, which
+// GitLab never sends. Existing calls become ScheduleAllStorageMoves(nil),
+// returning only the response and error.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+func (s ProjectRepositoryStorageMoveService) ScheduleAllStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "project_repository_storage_moves", optionalBody(opt), options)
 	if err != nil {
-		return nil, resp, err
+		return nil, err
 	}
 
-	return psms, resp, err
+	return s.client.Do(req, nil)
+}
+
+// ScheduleStorageMoveForProjectOptions represents the available
+// ScheduleStorageMoveForProject() and ScheduleStorageMoveForProjectOne()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+type ScheduleStorageMoveForProjectOptions struct {
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
 }
 
 // ScheduleStorageMoveForProject schedule a repository to be moved for a project.
+// If opt is nil, no body is sent and GitLab picks the destination storage.
+//
+// This method used to take no options. Existing calls become
+// ScheduleStorageMoveForProject(project, nil).
+//
+// GitLab responds with a single storage move for this endpoint, so consider
+// using ScheduleStorageMoveForProjectOne instead, which returns it as such.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
-func (s ProjectRepositoryStorageMoveService) ScheduleStorageMoveForProject(project int, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+func (s ProjectRepositoryStorageMoveService) ScheduleStorageMoveForProject(project int, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
 	u := fmt.Sprintf("projects/%d/repository_storage_moves", project)
 
-	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	req, err := s.client.NewRequest(http.MethodPost, u, optionalBody(opt), options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,3 +655,31 @@ func (s ProjectRepositoryStorageMoveService) ScheduleStorageMoveForProject(proje
 
 	return psms, resp, err
 }
+
+// ScheduleStorageMoveForProjectOne schedules a repository to be moved for a
+// project and returns the single storage move GitLab created. If opt is nil,
+// GitLab picks the destination storage. Unlike
+// ScheduleAllStorageMoves, which schedules a move for every repository on a
+// storage shard, this endpoint always creates exactly one move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+func (s ProjectRepositoryStorageMoveService) ScheduleStorageMoveForProjectOne(project int, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("projects/%d/repository_storage_moves", project)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, optionalBody(opt), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Do[ProjectRepositoryStorageMove](s.client, req)
+}
+
+// optionalBody returns opt as an interface, or nil when opt is a nil pointer
+// so NewRequest sends no body instead of a JSON null.
+func optionalBody[T any](opt *T) interface{} {
+	if opt == nil {
+		return nil
+	}
+	return opt
+}
@@ -0,0 +1,559 @@
+//
+// Copyright 2023, Nick Westbury
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRepositoryStorageMoveService_ScheduleStorageMoveForProjectOne(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		fmt.Fprint(w, `{"id": 123, "state": "scheduled", "source_storage_name": "default", "destination_storage_name": "storage2", "project": {"id": 1}}`)
+	})
+
+	want := &ProjectRepositoryStorageMove{
+		ID:                     123,
+		State:                  "scheduled",
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+	}
+	want.Project.ID = 1
+
+	opts := &ScheduleStorageMoveForProjectOptions{DestinationStorageName: String("storage2")}
+
+	psm, resp, err := client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProjectOne(1, opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, psm)
+
+	psm, resp, err = client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProjectOne(1, opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, psm)
+
+	psm, resp, err = client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProjectOne(2, opts)
+	require.Error(t, err)
+	require.Nil(t, psm)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestProjectRepositoryStorageMoveService_ScheduleStorageMoveForProject(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		fmt.Fprint(w, `[{"id": 123, "state": "scheduled", "destination_storage_name": "storage2"}]`)
+	})
+
+	opts := &ScheduleStorageMoveForProjectOptions{DestinationStorageName: String("storage2")}
+
+	psms, resp, err := client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProject(1, opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, psms, 1)
+	require.Equal(t, "storage2", psms[0].DestinationStorageName)
+}
+
+func TestProjectRepositoryStorageMoveService_ScheduleStorageMoveForProjectWithoutDestination(t *testing.T) {
+	mux, client := setup(t)
+
+	wantBody := ""
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, wantBody)
+		fmt.Fprint(w, `[{"id": 123, "state": "scheduled", "destination_storage_name": "storage3"}]`)
+	})
+
+	// Without options no body is sent, as before options were supported.
+	psms, resp, err := client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProject(1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, psms, 1)
+
+	wantBody = `{}`
+	psms, resp, err = client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProject(1, &ScheduleStorageMoveForProjectOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, psms, 1)
+}
+
+func TestProjectRepositoryStorageMoveService_ScheduleAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message": "202 Accepted"}`)
+	})
+
+	opts := &ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	}
+
+	resp, err := client.ProjectRepositoryStorageMove.ScheduleAllStorageMoves(opts)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, err = client.ProjectRepositoryStorageMove.ScheduleAllStorageMoves(opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+}
+
+func TestProjectRepositoryStorageMoveService_RetrieveAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	var wantParams string
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, wantParams)
+		fmt.Fprint(w, `[{"id": 1, "state": "finished", "source_storage_name": "default"}]`)
+	})
+
+	wantParams = "page=1&per_page=2&source_storage_name=default"
+	opts := RetrieveAllStorageMovesOptions{
+		ListOptions:       ListOptions{Page: 1, PerPage: 2},
+		SourceStorageName: String("default"),
+	}
+
+	psms, resp, err := client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, psms, 1)
+	require.Equal(t, "default", psms[0].SourceStorageName)
+
+	wantParams = "page=1&per_page=2"
+	opts.SourceStorageName = nil
+
+	_, _, err = client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(opts)
+	require.NoError(t, err)
+}
+
+func TestProjectRepositoryStorageMoveService_RetrieveStorageMovesByState(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "":
+			testParams(t, r, "per_page=2")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1, "state": "finished"}, {"id": 2, "state": "failed"}]`)
+		case "2":
+			testParams(t, r, "page=2&per_page=2")
+			fmt.Fprint(w, `[{"id": 3, "state": "scheduled"}, {"id": 4, "state": "failed"}]`)
+		default:
+			t.Fatalf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	psms, resp, err := client.ProjectRepositoryStorageMove.RetrieveStorageMovesByState("failed", RetrieveAllStorageMovesOptions{ListOptions: ListOptions{PerPage: 2}})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, psms, 2)
+	require.Equal(t, 2, psms[0].ID)
+	require.Equal(t, 4, psms[1].ID)
+
+	psms, resp, err = client.ProjectRepositoryStorageMove.RetrieveStorageMovesByState("failed", RetrieveAllStorageMovesOptions{}, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, psms)
+}
+
+func TestProjectRepositoryStorageMoveService_WaitForStorageMove(t *testing.T) {
+	mux, client := setup(t)
+
+	states := []string{"scheduled", "started", "finished"}
+	calls := 0
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"id": 123, "state": %q}`, states[calls])
+		calls++
+	})
+
+	psm, err := client.ProjectRepositoryStorageMove.WaitForStorageMove(1, 123, WaitOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, 123, psm.ID)
+	require.Equal(t, StorageMoveFinished, psm.State)
+	require.Equal(t, 3, calls)
+}
+
+func TestProjectRepositoryStorageMoveService_WaitForStorageMoveFailed(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 123, "state": "failed"}`)
+	})
+
+	psm, err := client.ProjectRepositoryStorageMove.WaitForStorageMove(1, 123, WaitOptions{PollInterval: time.Millisecond})
+	require.ErrorIs(t, err, ErrStorageMoveFailed)
+	require.Equal(t, StorageMoveFailed, psm.State)
+}
+
+func TestProjectRepositoryStorageMoveService_WaitForStorageMoveTimeout(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 123, "state": "started"}`)
+	})
+
+	psm, err := client.ProjectRepositoryStorageMove.WaitForStorageMove(1, 123, WaitOptions{PollInterval: time.Hour, Timeout: 50 * time.Millisecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, StorageMoveStarted, psm.State)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	psm, err = client.ProjectRepositoryStorageMove.WaitForStorageMove(1, 123, WaitOptions{Context: ctx})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, psm)
+}
+
+func TestStorageMoveState(t *testing.T) {
+	var psm ProjectRepositoryStorageMove
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "state": "moving to the moon"}`), &psm))
+	require.Equal(t, StorageMoveState("moving to the moon"), psm.State)
+	require.False(t, psm.IsTerminal())
+
+	b, err := json.Marshal(&psm)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"state":"moving to the moon"`)
+
+	for state, terminal := range map[StorageMoveState]bool{
+		StorageMoveInitial:       false,
+		StorageMoveScheduled:     false,
+		StorageMoveStarted:       false,
+		StorageMoveReplicated:    false,
+		StorageMoveFinished:      true,
+		StorageMoveFailed:        true,
+		StorageMoveCleanupFailed: true,
+	} {
+		psm := &ProjectRepositoryStorageMove{State: state}
+		require.Equal(t, terminal, psm.IsTerminal(), state)
+	}
+}
+
+func TestFilterByState(t *testing.T) {
+	moves := []*ProjectRepositoryStorageMove{
+		{ID: 1, State: StorageMoveStarted},
+		{ID: 2, State: StorageMoveFailed},
+		{ID: 3, State: StorageMoveFinished},
+		{ID: 4, State: StorageMoveCleanupFailed},
+	}
+
+	failed := FilterByState(moves, StorageMoveFailed, StorageMoveCleanupFailed)
+	require.Len(t, failed, 2)
+	require.Equal(t, 2, failed[0].ID)
+	require.Equal(t, 4, failed[1].ID)
+
+	require.Empty(t, FilterByState(moves, StorageMoveScheduled))
+	require.Empty(t, FilterByState(moves))
+}
+
+func TestProjectRepositoryStorageMoveService_WaitForAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	states := map[string][]string{
+		"1": {"scheduled", "started", "finished"},
+		"2": {"started", "failed"},
+		"3": {"finished"},
+	}
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		id := strings.TrimPrefix(r.URL.Path, "/api/v4/project_repository_storage_moves/")
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		moveStates, ok := states[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := calls[id]
+		if i >= len(moveStates) {
+			i = len(moveStates) - 1
+		}
+		calls[id]++
+
+		fmt.Fprintf(w, `{"id": %s, "state": %q}`, id, moveStates[i])
+	})
+
+	psms, err := client.ProjectRepositoryStorageMove.WaitForAllStorageMoves(context.Background(), []int{1, 2, 3}, time.Millisecond)
+	require.ErrorIs(t, err, ErrStorageMoveFailed)
+	require.EqualError(t, err, "1 repository storage moves failed: move 2: repository storage move failed")
+
+	var smErr *StorageMovesError
+	require.ErrorAs(t, err, &smErr)
+	require.Len(t, smErr.Errors, 1)
+	require.Contains(t, smErr.Errors, 2)
+
+	require.Len(t, psms, 3)
+	require.Equal(t, StorageMoveFinished, psms[1].State)
+	require.Equal(t, StorageMoveFailed, psms[2].State)
+	require.Equal(t, StorageMoveFinished, psms[3].State)
+
+	mu.Lock()
+	calls = map[string]int{}
+	mu.Unlock()
+
+	psms, err = client.ProjectRepositoryStorageMove.WaitForAllStorageMoves(context.Background(), []int{1, 3}, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, StorageMoveFinished, psms[1].State)
+	require.Equal(t, StorageMoveFinished, psms[3].State)
+}
+
+func TestProjectRepositoryStorageMoveService_GetStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch id := strings.TrimPrefix(r.URL.Path, "/api/v4/project_repository_storage_moves/"); id {
+		case "1", "3", "5":
+			fmt.Fprintf(w, `{"id": %s, "state": "finished"}`, id)
+		case "4":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	psms, err := client.ProjectRepositoryStorageMove.GetStorageMoves(context.Background(), []int{5, 2, 1, 4, 3}, 2)
+	require.Error(t, err)
+
+	var smErr *StorageMovesError
+	require.ErrorAs(t, err, &smErr)
+	require.Len(t, smErr.Errors, 2)
+	require.Contains(t, smErr.Errors, 2)
+	require.Contains(t, smErr.Errors, 4)
+
+	require.Len(t, psms, 5)
+	require.Equal(t, 5, psms[0].ID)
+	require.Nil(t, psms[1])
+	require.Equal(t, 1, psms[2].ID)
+	require.Nil(t, psms[3])
+	require.Equal(t, 3, psms[4].ID)
+
+	psms, err = client.ProjectRepositoryStorageMove.GetStorageMoves(context.Background(), []int{3, 1}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, psms[0].ID)
+	require.Equal(t, 1, psms[1].ID)
+}
+
+func TestProjectRepositoryStorageMoveService_GetStorageMovesCancelled(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for %s", r.URL.Path)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	psms, err := client.ProjectRepositoryStorageMove.GetStorageMoves(ctx, []int{1, 2}, 1)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []*ProjectRepositoryStorageMove{nil, nil}, psms)
+}
+
+func TestProjectRepositoryStorageMoveService_DrainShard(t *testing.T) {
+	mux, client := setup(t)
+
+	scheduled := false
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+			scheduled = true
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"message": "202 Accepted"}`)
+		case http.MethodGet:
+			if !scheduled {
+				t.Error("moves listed before they were scheduled")
+			}
+			testParams(t, r, "source_storage_name=default")
+			// Move 4 is an earlier, finished move and move 5 is on another
+			// storage, as returned by GitLab versions ignoring the filter.
+			fmt.Fprint(w, `[
+				{"id": 1, "state": "scheduled", "source_storage_name": "default"},
+				{"id": 2, "state": "scheduled", "source_storage_name": "default"},
+				{"id": 3, "state": "scheduled", "source_storage_name": "default"},
+				{"id": 4, "state": "finished", "source_storage_name": "default"},
+				{"id": 5, "state": "scheduled", "source_storage_name": "other"}
+			]`)
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+
+	var mu sync.Mutex
+	calls := map[string]int{}
+	states := map[string][]string{
+		"1": {"started", "finished"},
+		"2": {"started", "failed"},
+		"3": {"finished"},
+	}
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		id := strings.TrimPrefix(r.URL.Path, "/api/v4/project_repository_storage_moves/")
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		moveStates, ok := states[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := calls[id]
+		if i >= len(moveStates) {
+			i = len(moveStates) - 1
+		}
+		calls[id]++
+
+		fmt.Fprintf(w, `{"id": %s, "state": %q}`, id, moveStates[i])
+	})
+
+	var transitions []string
+	opts := DrainOptions{
+		DestinationStorageName: String("storage2"),
+		PollInterval:           time.Millisecond,
+		MaxConcurrentPolls:     2,
+		OnStateChange: func(move *ProjectRepositoryStorageMove, previousState StorageMoveState) {
+			transitions = append(transitions, fmt.Sprintf("%d:%s->%s", move.ID, previousState, move.State))
+		},
+	}
+
+	psms, err := client.ProjectRepositoryStorageMove.DrainShard(context.Background(), "default", opts)
+	require.ErrorIs(t, err, ErrStorageMoveFailed)
+
+	var smErr *StorageMovesError
+	require.ErrorAs(t, err, &smErr)
+	require.Len(t, smErr.Errors, 1)
+	require.Contains(t, smErr.Errors, 2)
+
+	require.Len(t, psms, 3)
+	require.Equal(t, 1, psms[0].ID)
+	require.Equal(t, StorageMoveFinished, psms[0].State)
+	require.Equal(t, StorageMoveFailed, psms[1].State)
+	require.Equal(t, StorageMoveFinished, psms[2].State)
+
+	require.ElementsMatch(t, []string{
+		"1:scheduled->started",
+		"1:started->finished",
+		"2:scheduled->started",
+		"2:started->failed",
+		"3:scheduled->finished",
+	}, transitions)
+}
+
+func TestProjectRepositoryStorageMoveService_DrainShardScheduleError(t *testing.T) {
+	_, client := setup(t)
+
+	psms, err := client.ProjectRepositoryStorageMove.DrainShard(context.Background(), "default", DrainOptions{}, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, psms)
+}
+
+// fakeStorageMoveService is a hand-written fake showing how consumers can
+// replace Client.ProjectRepositoryStorageMove in their own unit tests.
+type fakeStorageMoveService struct {
+	ProjectRepositoryStorageMoveServiceInterface
+
+	moves map[int]*ProjectRepositoryStorageMove
+}
+
+func (f *fakeStorageMoveService) GetStorageMove(repositoryStorage int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	psm, ok := f.moves[repositoryStorage]
+	if !ok {
+		return nil, nil, fmt.Errorf("move %d not found", repositoryStorage)
+	}
+	return psm, nil, nil
+}
+
+func TestProjectRepositoryStorageMoveServiceInterface_Fake(t *testing.T) {
+	client, err := NewClient("")
+	require.NoError(t, err)
+
+	client.ProjectRepositoryStorageMove = &fakeStorageMoveService{
+		moves: map[int]*ProjectRepositoryStorageMove{123: {ID: 123, State: "finished"}},
+	}
+
+	psm, _, err := client.ProjectRepositoryStorageMove.GetStorageMove(123)
+	require.NoError(t, err)
+	require.Equal(t, StorageMoveFinished, psm.State)
+
+	_, _, err = client.ProjectRepositoryStorageMove.GetStorageMove(124)
+	require.EqualError(t, err, "move 124 not found")
+}
+
+func TestProjectRepositoryStorageMoveService_GetStorageMoveFixture(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		mustWriteHTTPResponse(t, w, "testdata/get_project_repository_storage_move.json")
+	})
+
+	want := &ProjectRepositoryStorageMove{
+		ID:                     1,
+		CreatedAt:              Time(time.Date(2020, time.May, 7, 4, 27, 17, 234000000, time.UTC)),
+		State:                  "scheduled",
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Project: StorageMoveProject{
+			ID:                1,
+			Name:              "project1",
+			NameWithNamespace: "John Doe2 / project1",
+			Path:              "project1",
+			PathWithNamespace: "namespace1/project1",
+			CreatedAt:         Time(time.Date(2020, time.May, 7, 4, 27, 17, 16000000, time.UTC)),
+		},
+	}
+
+	psm, _, err := client.ProjectRepositoryStorageMove.GetStorageMove(1)
+	require.NoError(t, err)
+	require.Equal(t, want, psm)
+
+	b, err := json.Marshal(psm)
+	require.NoError(t, err)
+
+	var roundTrip ProjectRepositoryStorageMove
+	require.NoError(t, json.Unmarshal(b, &roundTrip))
+	require.Equal(t, want, &roundTrip)
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -207,3 +208,29 @@ func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, op
 
 	return b.Bytes(), resp, err
 }
+
+// GetSnippetFileRaw streams the raw content of a single file of a project
+// snippet at the given ref to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_snippets.html#get-raw-file-content
+func (s *ProjectSnippetsService) GetSnippetFileRaw(pid interface{}, snippet int, ref, filePath string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/snippets/%d/files/%s/%s/raw",
+		PathEscape(project),
+		snippet,
+		PathEscape(ref),
+		PathEscape(filePath),
+	)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"testing"
@@ -388,3 +389,31 @@ func TestProjectSnippetsService_SnippetContent(t *testing.T) {
 	require.Nil(t, s)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestProjectSnippetsService_GetSnippetFileRaw(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/1/files/main/config/app.yml/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURL(t, r, "/api/v4/projects/1/snippets/1/files/main/config%2Fapp%2Eyml/raw")
+		fmt.Fprint(w, "listen: 8080\n")
+	})
+
+	var b bytes.Buffer
+	resp, err := client.ProjectSnippets.GetSnippetFileRaw(1, 1, "main", "config/app.yml", &b)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, "listen: 8080\n", b.String())
+
+	resp, err = client.ProjectSnippets.GetSnippetFileRaw(1.01, 1, "main", "config/app.yml", &b)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+
+	resp, err = client.ProjectSnippets.GetSnippetFileRaw(1, 1, "main", "config/app.yml", &b, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+
+	resp, err = client.ProjectSnippets.GetSnippetFileRaw(2, 1, "main", "config/app.yml", &b)
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
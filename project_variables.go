@@ -18,8 +18,10 @@ package gitlab
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // ProjectVariablesService handles communication with the
@@ -49,6 +51,108 @@ func (v ProjectVariable) String() string {
 	return Stringify(v)
 }
 
+// ValidateMaskedValue checks if value meets the requirements GitLab has for
+// the value of a masked variable. The value must be a single line of at least
+// 8 characters, consisting only of characters from the Base64 alphabet
+// (RFC4648) and the @, :, ., ~, - and _ characters.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/variables/#mask-a-cicd-variable
+func ValidateMaskedValue(value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("masked variable value must be a single line")
+	}
+	if len(value) < 8 {
+		return fmt.Errorf("masked variable value must be at least 8 characters long, got %d", len(value))
+	}
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("+/=@:.~-_", r):
+		default:
+			return fmt.Errorf("masked variable value contains invalid character %q", r)
+		}
+	}
+	return nil
+}
+
+// MatchVariableScope reports whether a variable with the given environment
+// scope applies to the named environment. A scope may contain any number of
+// * wildcards, each matching any sequence of characters (including /), so
+// "review/*" matches "review/feature-1". An empty scope is treated as "*".
+// When environment is empty, only the "*" scope matches.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/environments/index.html#limit-the-environment-scope-of-a-cicd-variable
+func MatchVariableScope(scope, environment string) bool {
+	if scope == "" || scope == "*" {
+		return true
+	}
+	if environment == "" {
+		return false
+	}
+
+	parts := strings.Split(scope, "*")
+	if len(parts) == 1 {
+		return scope == environment
+	}
+
+	// The first part has to be a prefix and the last part a suffix, while
+	// all parts in between have to appear in order.
+	if !strings.HasPrefix(environment, parts[0]) {
+		return false
+	}
+	rest := environment[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(rest, part)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(part):]
+	}
+	return strings.HasSuffix(rest, last)
+}
+
+// ResolveVariableForEnvironment returns the variable with the given key that
+// takes effect in the named environment, or nil if none of the variables
+// apply. Following GitLab's precedence rules, a scope that exactly matches
+// the environment name wins over wildcard scopes, and any other wildcard
+// scope wins over "*". Between multiple matching wildcard scopes, the longest
+// (most specific) scope wins.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/ci/variables/#cicd-variable-precedence
+func ResolveVariableForEnvironment(vars []*ProjectVariable, key, environment string) *ProjectVariable {
+	var (
+		best     *ProjectVariable
+		bestRank int
+	)
+	for _, v := range vars {
+		if v == nil || v.Key != key || !MatchVariableScope(v.EnvironmentScope, environment) {
+			continue
+		}
+		if rank := variableScopeRank(v.EnvironmentScope); best == nil || rank > bestRank {
+			best, bestRank = v, rank
+		}
+	}
+	return best
+}
+
+// variableScopeRank returns the precedence of a matching environment scope.
+// Exact scopes rank above all wildcard scopes, which rank by their length
+// above the catch-all "*" scope.
+func variableScopeRank(scope string) int {
+	switch {
+	case scope == "" || scope == "*":
+		return 0
+	case !strings.Contains(scope, "*"):
+		return math.MaxInt32
+	default:
+		return len(scope)
+	}
+}
+
 // VariableFilter filters available for project variable related functions
 type VariableFilter struct {
 	EnvironmentScope string `url:"environment_scope, omitempty" json:"environment_scope,omitempty"`
@@ -144,6 +248,11 @@ func (s *ProjectVariablesService) CreateVariable(pid interface{}, opt *CreatePro
 	if err != nil {
 		return nil, nil, err
 	}
+	if opt != nil && opt.Masked != nil && *opt.Masked && opt.Value != nil {
+		if err := ValidateMaskedValue(*opt.Value); err != nil {
+			return nil, nil, err
+		}
+	}
 	u := fmt.Sprintf("projects/%s/variables", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
@@ -184,6 +293,11 @@ func (s *ProjectVariablesService) UpdateVariable(pid interface{}, key string, op
 	if err != nil {
 		return nil, nil, err
 	}
+	if opt != nil && opt.Masked != nil && *opt.Masked && opt.Value != nil {
+		if err := ValidateMaskedValue(*opt.Value); err != nil {
+			return nil, nil, err
+		}
+	}
 	u := fmt.Sprintf("projects/%s/variables/%s", PathEscape(project), url.PathEscape(key))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
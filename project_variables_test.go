@@ -146,6 +146,15 @@ func TestProjectVariablesService_CreateVariable(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, pv)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	pv, resp, err = client.ProjectVariables.CreateVariable(1, &CreateProjectVariableOptions{
+		Key:    String("NEW_VARIABLE"),
+		Value:  String("short"),
+		Masked: Bool(true),
+	})
+	require.EqualError(t, err, "masked variable value must be at least 8 characters long, got 5")
+	require.Nil(t, resp)
+	require.Nil(t, pv)
 }
 
 func TestProjectVariablesService_UpdateVariable(t *testing.T) {
@@ -220,3 +229,87 @@ func TestProjectVariablesService_RemoveVariable(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestValidateMaskedValue(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr string
+	}{
+		{value: "c2VjcmV0LXZhbHVl"},
+		{value: "user@example.com:token~1_2-3/4+5="},
+		{value: "short", wantErr: "masked variable value must be at least 8 characters long, got 5"},
+		{value: "multi\nline value", wantErr: "masked variable value must be a single line"},
+		{value: "has a space", wantErr: "masked variable value contains invalid character ' '"},
+		{value: "dollar$sign", wantErr: "masked variable value contains invalid character '$'"},
+	}
+
+	for _, tt := range tests {
+		err := ValidateMaskedValue(tt.value)
+		if tt.wantErr == "" {
+			require.NoError(t, err, tt.value)
+		} else {
+			require.EqualError(t, err, tt.wantErr, tt.value)
+		}
+	}
+}
+
+func TestMatchVariableScope(t *testing.T) {
+	tests := []struct {
+		scope, environment string
+		want               bool
+	}{
+		{scope: "*", environment: "production", want: true},
+		{scope: "", environment: "production", want: true},
+		{scope: "*", environment: "", want: true},
+		{scope: "production", environment: "production", want: true},
+		{scope: "production", environment: "production-eu", want: false},
+		{scope: "production", environment: "", want: false},
+		{scope: "review/*", environment: "review/feature-1", want: true},
+		{scope: "review/*", environment: "review/team/feature-1", want: true},
+		{scope: "review/*", environment: "staging", want: false},
+		{scope: "*/eu", environment: "production/eu", want: true},
+		{scope: "*/eu", environment: "production/us", want: false},
+		{scope: "review/*/app-*", environment: "review/team/app-1", want: true},
+		{scope: "review/*/app-*", environment: "review/team/web-1", want: false},
+		{scope: "Production", environment: "production", want: false},
+	}
+
+	for _, tt := range tests {
+		got := MatchVariableScope(tt.scope, tt.environment)
+		require.Equal(t, tt.want, got, "scope %q, environment %q", tt.scope, tt.environment)
+	}
+}
+
+func TestResolveVariableForEnvironment(t *testing.T) {
+	vars := []*ProjectVariable{
+		{Key: "URL", Value: "default", EnvironmentScope: "*"},
+		{Key: "URL", Value: "review", EnvironmentScope: "review/*"},
+		{Key: "URL", Value: "review-team", EnvironmentScope: "review/team/*"},
+		{Key: "URL", Value: "production", EnvironmentScope: "production"},
+		{Key: "TOKEN", Value: "secret", EnvironmentScope: "production"},
+	}
+
+	tests := []struct {
+		key, environment string
+		want             string
+	}{
+		{key: "URL", environment: "production", want: "production"},
+		{key: "URL", environment: "staging", want: "default"},
+		{key: "URL", environment: "review/feature-1", want: "review"},
+		{key: "URL", environment: "review/team/feature-1", want: "review-team"},
+		{key: "URL", environment: "", want: "default"},
+		{key: "TOKEN", environment: "production", want: "secret"},
+		{key: "TOKEN", environment: "staging"},
+		{key: "MISSING", environment: "production"},
+	}
+
+	for _, tt := range tests {
+		got := ResolveVariableForEnvironment(vars, tt.key, tt.environment)
+		if tt.want == "" {
+			require.Nil(t, got, "key %q, environment %q", tt.key, tt.environment)
+			continue
+		}
+		require.NotNil(t, got, "key %q, environment %q", tt.key, tt.environment)
+		require.Equal(t, tt.want, got.Value, "key %q, environment %q", tt.key, tt.environment)
+	}
+}
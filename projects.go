@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -90,6 +91,7 @@ type Project struct {
 	PrintingMergeRequestLinkEnabled           bool                       `json:"printing_merge_request_link_enabled"`
 	LFSEnabled                                bool                       `json:"lfs_enabled"`
 	RepositoryStorage                         string                     `json:"repository_storage"`
+	RepositorySizeLimit                       int                        `json:"repository_size_limit"`
 	RequestAccessEnabled                      bool                       `json:"request_access_enabled"`
 	MergeMethod                               MergeMethodValue           `json:"merge_method"`
 	CanCreateMergeRequestIn                   bool                       `json:"can_create_merge_request_in"`
@@ -510,6 +512,9 @@ type ProjectLanguages map[string]float32
 
 // GetProjectLanguages gets a list of languages used by the project
 //
+// The languages endpoint does not accept a ref: the percentages are always
+// calculated by GitLab for the default branch of the repository.
+//
 // GitLab API docs:  https://docs.gitlab.com/ee/api/projects.html#languages
 func (s *ProjectsService) GetProjectLanguages(pid interface{}, options ...RequestOptionFunc) (*ProjectLanguages, *Response, error) {
 	project, err := parseID(pid)
@@ -623,6 +628,7 @@ type CreateProjectOptions struct {
 	RemoveSourceBranchAfterMerge              *bool                                `url:"remove_source_branch_after_merge,omitempty" json:"remove_source_branch_after_merge,omitempty"`
 	PrintingMergeRequestLinkEnabled           *bool                                `url:"printing_merge_request_link_enabled,omitempty" json:"printing_merge_request_link_enabled,omitempty"`
 	RepositoryAccessLevel                     *AccessControlValue                  `url:"repository_access_level,omitempty" json:"repository_access_level,omitempty"`
+	RepositorySizeLimit                       *int                                 `url:"repository_size_limit,omitempty" json:"repository_size_limit,omitempty"`
 	RepositoryStorage                         *string                              `url:"repository_storage,omitempty" json:"repository_storage,omitempty"`
 	RequestAccessEnabled                      *bool                                `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
 	RequirementsAccessLevel                   *AccessControlValue                  `url:"requirements_access_level,omitempty" json:"requirements_access_level,omitempty"`
@@ -850,6 +856,7 @@ type EditProjectOptions struct {
 	RemoveSourceBranchAfterMerge              *bool                                `url:"remove_source_branch_after_merge,omitempty" json:"remove_source_branch_after_merge,omitempty"`
 	PrintingMergeRequestLinkEnabled           *bool                                `url:"printing_merge_request_link_enabled,omitempty" json:"printing_merge_request_link_enabled,omitempty"`
 	RepositoryAccessLevel                     *AccessControlValue                  `url:"repository_access_level,omitempty" json:"repository_access_level,omitempty"`
+	RepositorySizeLimit                       *int                                 `url:"repository_size_limit,omitempty" json:"repository_size_limit,omitempty"`
 	RepositoryStorage                         *string                              `url:"repository_storage,omitempty" json:"repository_storage,omitempty"`
 	RequestAccessEnabled                      *bool                                `url:"request_access_enabled,omitempty" json:"request_access_enabled,omitempty"`
 	RequirementsAccessLevel                   *AccessControlValue                  `url:"requirements_access_level,omitempty" json:"requirements_access_level,omitempty"`
@@ -928,6 +935,23 @@ func (s *ProjectsService) EditProject(pid interface{}, opt *EditProjectOptions,
 	return p, resp, nil
 }
 
+// SetProjectStorageLimit sets the repository size limit of a project. The
+// limit is given in bytes and rounded up to whole megabytes, as that is the
+// unit the API expects. A limit of 0 means the project inherits the limit of
+// its group or of the instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#edit-project
+func (s *ProjectsService) SetProjectStorageLimit(pid interface{}, size int64, options ...RequestOptionFunc) (*Project, *Response, error) {
+	if size < 0 {
+		return nil, nil, fmt.Errorf("invalid repository size limit %d, the limit can't be negative", size)
+	}
+
+	const megabyte = 1024 * 1024
+	limit := int((size + megabyte - 1) / megabyte)
+
+	return s.EditProject(pid, &EditProjectOptions{RepositorySizeLimit: &limit}, options...)
+}
+
 // ForkProjectOptions represents the available ForkProject() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#fork-project
@@ -1157,24 +1181,44 @@ type ProjectMember struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#list-project-hooks
 type ProjectHook struct {
-	ID                       int        `json:"id"`
-	URL                      string     `json:"url"`
-	ConfidentialNoteEvents   bool       `json:"confidential_note_events"`
-	ProjectID                int        `json:"project_id"`
-	PushEvents               bool       `json:"push_events"`
-	PushEventsBranchFilter   string     `json:"push_events_branch_filter"`
-	IssuesEvents             bool       `json:"issues_events"`
-	ConfidentialIssuesEvents bool       `json:"confidential_issues_events"`
-	MergeRequestsEvents      bool       `json:"merge_requests_events"`
-	TagPushEvents            bool       `json:"tag_push_events"`
-	NoteEvents               bool       `json:"note_events"`
-	JobEvents                bool       `json:"job_events"`
-	PipelineEvents           bool       `json:"pipeline_events"`
-	WikiPageEvents           bool       `json:"wiki_page_events"`
-	DeploymentEvents         bool       `json:"deployment_events"`
-	ReleasesEvents           bool       `json:"releases_events"`
-	EnableSSLVerification    bool       `json:"enable_ssl_verification"`
-	CreatedAt                *time.Time `json:"created_at"`
+	ID                       int                       `json:"id"`
+	URL                      string                    `json:"url"`
+	ConfidentialNoteEvents   bool                      `json:"confidential_note_events"`
+	ProjectID                int                       `json:"project_id"`
+	PushEvents               bool                      `json:"push_events"`
+	PushEventsBranchFilter   string                    `json:"push_events_branch_filter"`
+	IssuesEvents             bool                      `json:"issues_events"`
+	ConfidentialIssuesEvents bool                      `json:"confidential_issues_events"`
+	MergeRequestsEvents      bool                      `json:"merge_requests_events"`
+	TagPushEvents            bool                      `json:"tag_push_events"`
+	NoteEvents               bool                      `json:"note_events"`
+	JobEvents                bool                      `json:"job_events"`
+	PipelineEvents           bool                      `json:"pipeline_events"`
+	WikiPageEvents           bool                      `json:"wiki_page_events"`
+	DeploymentEvents         bool                      `json:"deployment_events"`
+	ReleasesEvents           bool                      `json:"releases_events"`
+	EnableSSLVerification    bool                      `json:"enable_ssl_verification"`
+	URLVariables             []ProjectHookURLVariable  `json:"url_variables"`
+	CustomHeaders            []ProjectHookCustomHeader `json:"custom_headers"`
+	CreatedAt                *time.Time                `json:"created_at"`
+}
+
+// ProjectHookURLVariable represents a URL variable of a project hook. GitLab
+// never returns the secret value, so only the key is available.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook
+type ProjectHookURLVariable struct {
+	Key string `json:"key"`
+}
+
+// ProjectHookCustomHeader represents a custom header of a project hook.
+// GitLab never returns the secret value, so only the key is available.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook
+type ProjectHookCustomHeader struct {
+	Key string `json:"key"`
 }
 
 // ListProjectHooksOptions represents the available ListProjectHooks() options.
@@ -1348,6 +1392,79 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 	return s.client.Do(req, nil)
 }
 
+// HookDelivery represents a logged delivery of a project or group hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook-events
+type HookDelivery struct {
+	ID                int                    `json:"id"`
+	URL               string                 `json:"url"`
+	Trigger           string                 `json:"trigger"`
+	RequestHeaders    map[string]string      `json:"request_headers"`
+	RequestData       map[string]interface{} `json:"request_data"`
+	ResponseHeaders   map[string]string      `json:"response_headers"`
+	ResponseBody      string                 `json:"response_body"`
+	ResponseStatus    string                 `json:"response_status"`
+	ExecutionDuration float64                `json:"execution_duration"`
+	CreatedAt         *time.Time             `json:"created_at"`
+	UpdatedAt         *time.Time             `json:"updated_at"`
+}
+
+// ListHookEventsOptions represents the available ListProjectHookEvents() and
+// ListGroupHookEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook-events
+type ListHookEventsOptions struct {
+	ListOptions
+	Status *string `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListProjectHookEvents gets the events logged for a project hook over the
+// last 7 days.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#get-project-hook-events
+func (s *ProjectsService) ListProjectHookEvents(pid interface{}, hook int, opt *ListHookEventsOptions, options ...RequestOptionFunc) ([]*HookDelivery, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events", PathEscape(project), hook)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*HookDelivery
+	resp, err := s.client.Do(req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return events, resp, nil
+}
+
+// ResendProjectHookEvent resends a logged event of a project hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#resend-project-hook-event
+func (s *ProjectsService) ResendProjectHookEvent(pid interface{}, hook int, event int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/events/%d/resend", PathEscape(project), hook, event)
+
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ProjectForkRelation represents a project fork relationship.
 //
 // GitLab API docs:
@@ -1479,6 +1596,39 @@ func (s *ProjectsService) UploadAvatar(pid interface{}, avatar io.Reader, filena
 	return p, resp, nil
 }
 
+// DownloadAvatar downloads a project avatar.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#download-a-project-avatar
+func (s *ProjectsService) DownloadAvatar(pid interface{}, options ...RequestOptionFunc) (*bytes.Reader, *Response, error) {
+	avatar := new(bytes.Buffer)
+	resp, err := s.StreamAvatar(pid, avatar, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bytes.NewReader(avatar.Bytes()), resp, err
+}
+
+// StreamAvatar streams a project avatar to the provided io.Writer.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#download-a-project-avatar
+func (s *ProjectsService) StreamAvatar(pid interface{}, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/avatar", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // ListProjectForks gets a list of project forks.
 //
 // GitLab API docs:
@@ -39,7 +39,7 @@ func TestListProjects(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Archived:    Bool(true),
 		OrderBy:     String("name"),
 		Sort:        String("asc"),
@@ -68,7 +68,7 @@ func TestListUserProjects(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Archived:    Bool(true),
 		OrderBy:     String("name"),
 		Sort:        String("asc"),
@@ -97,7 +97,7 @@ func TestListUserStarredProjects(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Archived:    Bool(true),
 		OrderBy:     String("name"),
 		Sort:        String("asc"),
@@ -127,7 +127,7 @@ func TestListProjectsUsersByID(t *testing.T) {
 	})
 
 	opt := &ListProjectUserOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Search:      String("query"),
 	}
 
@@ -152,7 +152,7 @@ func TestListProjectsUsersByName(t *testing.T) {
 	})
 
 	opt := &ListProjectUserOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Search:      String("query"),
 	}
 
@@ -177,7 +177,7 @@ func TestListProjectsGroupsByID(t *testing.T) {
 	})
 
 	opt := &ListProjectGroupOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Search:      String("query"),
 	}
 
@@ -202,7 +202,7 @@ func TestListProjectsGroupsByName(t *testing.T) {
 	})
 
 	opt := &ListProjectGroupOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Search:      String("query"),
 	}
 
@@ -226,7 +226,7 @@ func TestListOwnedProjects(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Archived:    Bool(true),
 		OrderBy:     String("name"),
 		Sort:        String("asc"),
@@ -256,7 +256,7 @@ func TestListStarredProjects(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{
-		ListOptions: ListOptions{2, 3},
+		ListOptions: ListOptions{Page: 2, PerPage: 3},
 		Archived:    Bool(true),
 		OrderBy:     String("name"),
 		Sort:        String("asc"),
@@ -384,6 +384,128 @@ func TestGetProjectWithOptions(t *testing.T) {
 	}
 }
 
+func TestGetProjectFeatureAccessLevels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"builds_access_level": "enabled",
+			"wiki_access_level": "disabled",
+			"snippets_access_level": "private",
+			"pages_access_level": "public",
+			"issues_access_level": "enabled",
+			"merge_requests_access_level": "private",
+			"repository_access_level": "enabled",
+			"forking_access_level": "disabled"
+		  }`)
+	})
+
+	want := &Project{
+		ID:                       1,
+		BuildsAccessLevel:        EnabledAccessControl,
+		WikiAccessLevel:          DisabledAccessControl,
+		SnippetsAccessLevel:      PrivateAccessControl,
+		PagesAccessLevel:         PublicAccessControl,
+		IssuesAccessLevel:        EnabledAccessControl,
+		MergeRequestsAccessLevel: PrivateAccessControl,
+		RepositoryAccessLevel:    EnabledAccessControl,
+		ForkingAccessLevel:       DisabledAccessControl,
+	}
+
+	project, _, err := client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returns an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.GetProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestGetProjectCommitTemplates(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"squash_option": "default_on",
+			"merge_commit_template": "Merge branch '%{source_branch}' into '%{target_branch}'",
+			"squash_commit_template": "%{title} (%{reference})"
+		}`)
+	})
+
+	want := &Project{
+		ID:                   1,
+		SquashOption:         SquashOptionDefaultOn,
+		MergeCommitTemplate:  "Merge branch '%{source_branch}' into '%{target_branch}'",
+		SquashCommitTemplate: "%{title} (%{reference})",
+	}
+
+	project, _, err := client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returns an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.GetProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestEditProjectCommitTemplates(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"merge_commit_template":"Merge %{source_branch}","squash_commit_template":"%{title}"}`)
+		fmt.Fprint(w, `{"id": 1, "merge_commit_template": "Merge %{source_branch}", "squash_commit_template": "%{title}"}`)
+	})
+
+	opt := &EditProjectOptions{
+		MergeCommitTemplate:  String("Merge %{source_branch}"),
+		SquashCommitTemplate: String("%{title}"),
+	}
+
+	project, _, err := client.Projects.EditProject(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.EditProject returns an error: %v", err)
+	}
+
+	want := &Project{
+		ID:                   1,
+		MergeCommitTemplate:  "Merge %{source_branch}",
+		SquashCommitTemplate: "%{title}",
+	}
+
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.EditProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestEditProjectFeatureAccessLevels(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"builds_access_level":"disabled","forking_access_level":"enabled","pages_access_level":"private","wiki_access_level":"disabled"}`)
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	opt := &EditProjectOptions{
+		BuildsAccessLevel:  AccessControl(DisabledAccessControl),
+		ForkingAccessLevel: AccessControl(EnabledAccessControl),
+		PagesAccessLevel:   AccessControl(PrivateAccessControl),
+		WikiAccessLevel:    AccessControl(DisabledAccessControl),
+	}
+
+	_, _, err := client.Projects.EditProject(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.EditProject returns an error: %v", err)
+	}
+}
+
 func TestCreateProject(t *testing.T) {
 	mux, client := setup(t)
 
@@ -543,7 +665,7 @@ func TestListProjectForks(t *testing.T) {
 	})
 
 	opt := &ListProjectsOptions{}
-	opt.ListOptions = ListOptions{2, 3}
+	opt.ListOptions = ListOptions{Page: 2, PerPage: 3}
 	opt.Archived = Bool(true)
 	opt.OrderBy = String("name")
 	opt.Sort = String("asc")
@@ -580,6 +702,99 @@ func TestShareProjectWithGroup(t *testing.T) {
 	}
 }
 
+func TestListProjectHookEvents(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=1&per_page=10&status=client_failure")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"url": "https://example.net/",
+			"trigger": "push_hooks",
+			"request_headers": {"Content-Type": "application/json", "X-Gitlab-Event": "Push Hook"},
+			"request_data": {"object_kind": "push"},
+			"response_headers": {"Content-Type": "text/plain"},
+			"response_body": "Page not found",
+			"execution_duration": 0.5,
+			"response_status": "404",
+			"created_at": "2023-05-04T12:00:00Z"
+		}]`)
+	})
+
+	createdAt := time.Date(2023, 5, 4, 12, 0, 0, 0, time.UTC)
+	want := []*HookDelivery{{
+		ID:                1,
+		URL:               "https://example.net/",
+		Trigger:           "push_hooks",
+		RequestHeaders:    map[string]string{"Content-Type": "application/json", "X-Gitlab-Event": "Push Hook"},
+		RequestData:       map[string]interface{}{"object_kind": "push"},
+		ResponseHeaders:   map[string]string{"Content-Type": "text/plain"},
+		ResponseBody:      "Page not found",
+		ExecutionDuration: 0.5,
+		ResponseStatus:    "404",
+		CreatedAt:         &createdAt,
+	}}
+
+	opt := &ListHookEventsOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 10},
+		Status:      String("client_failure"),
+	}
+
+	events, _, err := client.Projects.ListProjectHookEvents(1, 1, opt)
+	if err != nil {
+		t.Fatalf("Projects.ListProjectHookEvents returns an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, events) {
+		t.Errorf("Projects.ListProjectHookEvents returned %+v, want %+v", events, want)
+	}
+}
+
+func TestGetProjectHookSecretKeys(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"url": "https://example.net/{token}",
+			"url_variables": [{"key": "token"}],
+			"custom_headers": [{"key": "Authorization"}, {"key": "X-Tenant"}]
+		}`)
+	})
+
+	want := &ProjectHook{
+		ID:            1,
+		URL:           "https://example.net/{token}",
+		URLVariables:  []ProjectHookURLVariable{{Key: "token"}},
+		CustomHeaders: []ProjectHookCustomHeader{{Key: "Authorization"}, {Key: "X-Tenant"}},
+	}
+
+	hook, _, err := client.Projects.GetProjectHook(1, 1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectHook returns an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("Projects.GetProjectHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestResendProjectHookEvent(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/1/events/3/resend", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"response_status": 200}`)
+	})
+
+	_, err := client.Projects.ResendProjectHookEvent(1, 1, 3)
+	if err != nil {
+		t.Fatalf("Projects.ResendProjectHookEvent returns an error: %v", err)
+	}
+}
+
 func TestDeleteSharedProjectFromGroup(t *testing.T) {
 	mux, client := setup(t)
 
@@ -1384,3 +1599,131 @@ func TestProjectModelsOptionalMergeAttribute(t *testing.T) {
 	}
 	assert.False(t, strings.Contains(string(jsonString), "only_allow_merge_if_all_status_checks_passed"))
 }
+
+func TestCreateProjectForkRelation(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/fork/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": 10, "forked_to_project_id": 2, "forked_from_project_id": 1}`)
+	})
+
+	rel, _, err := client.Projects.CreateProjectForkRelation(2, 1)
+	if err != nil {
+		t.Errorf("Projects.CreateProjectForkRelation returned error: %v", err)
+	}
+
+	want := &ProjectForkRelation{ID: 10, ForkedToProjectID: 2, ForkedFromProjectID: 1}
+	if !reflect.DeepEqual(want, rel) {
+		t.Errorf("Projects.CreateProjectForkRelation returned %+v, want %+v", rel, want)
+	}
+}
+
+func TestDeleteProjectForkRelation(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/2/fork", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Projects.DeleteProjectForkRelation(2)
+	if err != nil {
+		t.Errorf("Projects.DeleteProjectForkRelation returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Projects.DeleteProjectForkRelation returned status code %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDownloadAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "avatar image")
+	})
+
+	avatar, _, err := client.Projects.DownloadAvatar(1)
+	if err != nil {
+		t.Fatalf("Projects.DownloadAvatar returns an error: %v", err)
+	}
+
+	got := new(bytes.Buffer)
+	if _, err := got.ReadFrom(avatar); err != nil {
+		t.Fatalf("Failed to read avatar: %v", err)
+	}
+	if got.String() != "avatar image" {
+		t.Errorf("Projects.DownloadAvatar returned %q, want %q", got.String(), "avatar image")
+	}
+}
+
+func TestStreamAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "avatar image")
+	})
+
+	got := new(bytes.Buffer)
+	_, err := client.Projects.StreamAvatar(1, got)
+	if err != nil {
+		t.Fatalf("Projects.StreamAvatar returns an error: %v", err)
+	}
+	if got.String() != "avatar image" {
+		t.Errorf("Projects.StreamAvatar wrote %q, want %q", got.String(), "avatar image")
+	}
+}
+
+func TestEditProjectRepositoryStorage(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"repository_size_limit":100,"repository_storage":"storage2"}`)
+		fmt.Fprint(w, `{"id":1,"repository_storage":"storage2","repository_size_limit":104857600}`)
+	})
+
+	opt := &EditProjectOptions{
+		RepositorySizeLimit: Int(100),
+		RepositoryStorage:   String("storage2"),
+	}
+
+	project, _, err := client.Projects.EditProject(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.EditProject returns an error: %v", err)
+	}
+
+	want := &Project{ID: 1, RepositoryStorage: "storage2", RepositorySizeLimit: 104857600}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.EditProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestSetProjectStorageLimit(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"repository_size_limit":2}`)
+		fmt.Fprint(w, `{"id":1,"repository_size_limit":2097152}`)
+	})
+
+	project, _, err := client.Projects.SetProjectStorageLimit(1, 1024*1024+1)
+	if err != nil {
+		t.Fatalf("Projects.SetProjectStorageLimit returns an error: %v", err)
+	}
+
+	want := &Project{ID: 1, RepositorySizeLimit: 2097152}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.SetProjectStorageLimit returned %+v, want %+v", project, want)
+	}
+
+	_, _, err = client.Projects.SetProjectStorageLimit(1, -1)
+	if err == nil {
+		t.Error("Projects.SetProjectStorageLimit expected an error for a negative limit")
+	}
+}
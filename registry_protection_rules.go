@@ -0,0 +1,174 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RegistryProtectionRulesService handles communication with the container
+// registry protection rules related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type RegistryProtectionRulesService struct {
+	client *Client
+}
+
+// RegistryProtectionRule represents a container registry protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type RegistryProtectionRule struct {
+	ID                          int                            `json:"id"`
+	ProjectID                   int                            `json:"project_id"`
+	RepositoryPathPattern       string                         `json:"repository_path_pattern"`
+	MinimumAccessLevelForPush   ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete ProtectionRuleAccessLevelValue `json:"minimum_access_level_for_delete"`
+}
+
+func (r RegistryProtectionRule) String() string {
+	return Stringify(r)
+}
+
+// ListRegistryProtectionRulesOptions represents the available
+// ListRegistryProtectionRules() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#list-container-repository-protection-rules
+type ListRegistryProtectionRulesOptions ListOptions
+
+// ListRegistryProtectionRules gets a list of container registry protection
+// rules of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#list-container-repository-protection-rules
+func (s *RegistryProtectionRulesService) ListRegistryProtectionRules(pid interface{}, opt *ListRegistryProtectionRulesOptions, options ...RequestOptionFunc) ([]*RegistryProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/rules", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []*RegistryProtectionRule
+	resp, err := s.client.Do(req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, nil
+}
+
+// CreateRegistryProtectionRuleOptions represents the available
+// CreateRegistryProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#create-a-container-repository-protection-rule
+type CreateRegistryProtectionRuleOptions struct {
+	RepositoryPathPattern       *string                         `url:"repository_path_pattern,omitempty" json:"repository_path_pattern,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// CreateRegistryProtectionRule creates a new container registry protection
+// rule for a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#create-a-container-repository-protection-rule
+func (s *RegistryProtectionRulesService) CreateRegistryProtectionRule(pid interface{}, opt *CreateRegistryProtectionRuleOptions, options ...RequestOptionFunc) (*RegistryProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/rules", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(RegistryProtectionRule)
+	resp, err := s.client.Do(req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// UpdateRegistryProtectionRuleOptions represents the available
+// UpdateRegistryProtectionRule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#update-a-container-repository-protection-rule
+type UpdateRegistryProtectionRuleOptions struct {
+	RepositoryPathPattern       *string                         `url:"repository_path_pattern,omitempty" json:"repository_path_pattern,omitempty"`
+	MinimumAccessLevelForPush   *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *ProtectionRuleAccessLevelValue `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// UpdateRegistryProtectionRule updates an existing container registry
+// protection rule of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#update-a-container-repository-protection-rule
+func (s *RegistryProtectionRulesService) UpdateRegistryProtectionRule(pid interface{}, rule int, opt *UpdateRegistryProtectionRuleOptions, options ...RequestOptionFunc) (*RegistryProtectionRule, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/rules/%d", PathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RegistryProtectionRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteRegistryProtectionRule deletes a container registry protection rule
+// from a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html#delete-a-container-repository-protection-rule
+func (s *RegistryProtectionRulesService) DeleteRegistryProtectionRule(pid interface{}, rule int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/registry/protection/rules/%d", PathEscape(project), rule)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,136 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryProtectionRulesService_ListRegistryProtectionRules(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "page=1&per_page=10")
+		fmt.Fprint(w, `[{"id": 1, "project_id": 7, "repository_path_pattern": "flightjs/flight0", "minimum_access_level_for_push": "maintainer", "minimum_access_level_for_delete": "owner"}]`)
+	})
+
+	want := []*RegistryProtectionRule{{
+		ID:                          1,
+		ProjectID:                   7,
+		RepositoryPathPattern:       "flightjs/flight0",
+		MinimumAccessLevelForPush:   ProtectionRuleAccessLevelMaintainer,
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevelOwner,
+	}}
+
+	rules, resp, err := client.RegistryProtectionRules.ListRegistryProtectionRules(7, &ListRegistryProtectionRulesOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rules)
+
+	rules, resp, err = client.RegistryProtectionRules.ListRegistryProtectionRules(7, nil, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rules)
+}
+
+func TestRegistryProtectionRulesService_CreateRegistryProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"repository_path_pattern":"flightjs/flight-*","minimum_access_level_for_push":"maintainer","minimum_access_level_for_delete":"admin"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "repository_path_pattern": "flightjs/flight-*", "minimum_access_level_for_push": "maintainer", "minimum_access_level_for_delete": "admin"}`)
+	})
+
+	want := &RegistryProtectionRule{
+		ID:                          2,
+		ProjectID:                   7,
+		RepositoryPathPattern:       "flightjs/flight-*",
+		MinimumAccessLevelForPush:   ProtectionRuleAccessLevelMaintainer,
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevelAdmin,
+	}
+
+	opt := &CreateRegistryProtectionRuleOptions{
+		RepositoryPathPattern:       String("flightjs/flight-*"),
+		MinimumAccessLevelForPush:   ProtectionRuleAccessLevel(ProtectionRuleAccessLevelMaintainer),
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevel(ProtectionRuleAccessLevelAdmin),
+	}
+
+	rule, resp, err := client.RegistryProtectionRules.CreateRegistryProtectionRule(7, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rule)
+
+	rule, resp, err = client.RegistryProtectionRules.CreateRegistryProtectionRule(7, opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rule)
+}
+
+func TestRegistryProtectionRulesService_UpdateRegistryProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `{"minimum_access_level_for_push":"owner"}`)
+		fmt.Fprint(w, `{"id": 2, "project_id": 7, "repository_path_pattern": "flightjs/flight-*", "minimum_access_level_for_push": "owner", "minimum_access_level_for_delete": "admin"}`)
+	})
+
+	want := &RegistryProtectionRule{
+		ID:                          2,
+		ProjectID:                   7,
+		RepositoryPathPattern:       "flightjs/flight-*",
+		MinimumAccessLevelForPush:   ProtectionRuleAccessLevelOwner,
+		MinimumAccessLevelForDelete: ProtectionRuleAccessLevelAdmin,
+	}
+
+	opt := &UpdateRegistryProtectionRuleOptions{
+		MinimumAccessLevelForPush: ProtectionRuleAccessLevel(ProtectionRuleAccessLevelOwner),
+	}
+
+	rule, resp, err := client.RegistryProtectionRules.UpdateRegistryProtectionRule(7, 2, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, rule)
+
+	rule, resp, err = client.RegistryProtectionRules.UpdateRegistryProtectionRule(7, 2, opt, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, rule)
+}
+
+func TestRegistryProtectionRulesService_DeleteRegistryProtectionRule(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/7/registry/protection/rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.RegistryProtectionRules.DeleteRegistryProtectionRule(7, 2)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	resp, err = client.RegistryProtectionRules.DeleteRegistryProtectionRule(7, 2, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+}
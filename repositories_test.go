@@ -173,6 +173,27 @@ func TestRepositoriesService_Archive(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestRepositoriesService_ArchivePath(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "path=services%2Fbilling&sha=main")
+		fmt.Fprint(w, "archive")
+	})
+
+	opt := &ArchiveOptions{
+		Format: String("tar.gz"),
+		Path:   String("services/billing"),
+		SHA:    String("main"),
+	}
+
+	b, resp, err := client.Repositories.Archive(1, opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, []byte("archive"), b)
+}
+
 func TestRepositoriesService_StreamArchive(t *testing.T) {
 	mux, client := setup(t)
 
@@ -115,12 +115,7 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 		PathEscape(fileName),
 	)
 
-	req, err := s.client.NewRequest(http.MethodHead, u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := s.client.Do(req, nil)
+	resp, err := s.client.HeadRequest(u, opt, options...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -147,6 +142,24 @@ func (s *RepositoryFilesService) GetFileMetaData(pid interface{}, fileName strin
 	return f, resp, nil
 }
 
+// FileExists checks whether a file exists in a repository using a HEAD
+// request. When the file exists, the metadata returned by GitLab in the
+// X-Gitlab-* response headers is returned as well.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
+func (s *RepositoryFilesService) FileExists(pid interface{}, fileName string, opt *GetFileMetaDataOptions, options ...RequestOptionFunc) (bool, *File, *Response, error) {
+	f, resp, err := s.GetFileMetaData(pid, fileName, opt, options...)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil, resp, nil
+		}
+		return false, nil, resp, err
+	}
+
+	return true, f, resp, nil
+}
+
 // FileBlameRange represents one item of blame information.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/repository_files.html
@@ -356,3 +356,43 @@ func TestRepositoryFilesService_DeleteFile(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
+
+func TestRepositoryFilesService_FileExists(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/app/models/key.rb", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodHead)
+		testURL(t, r, "/api/v4/projects/13083/repository/files/app%2Fmodels%2Fkey%2Erb?ref=master")
+		w.Header().Set("X-Gitlab-Blob-Id", "79f7bbd25901e8334750839545a9bd021f0e4c83")
+		w.Header().Set("X-Gitlab-Content-Sha256", "4c294617b60715c1d218e61164a3abd4808a4284cbc30e6728a01ad9aada4481")
+		w.Header().Set("X-Gitlab-File-Path", "app/models/key.rb")
+		w.Header().Set("X-Gitlab-Size", "1476")
+	})
+
+	want := &File{
+		FilePath: "app/models/key.rb",
+		Size:     1476,
+		BlobID:   "79f7bbd25901e8334750839545a9bd021f0e4c83",
+		SHA256:   "4c294617b60715c1d218e61164a3abd4808a4284cbc30e6728a01ad9aada4481",
+	}
+
+	opt := &GetFileMetaDataOptions{Ref: String("master")}
+
+	exists, f, resp, err := client.RepositoryFiles.FileExists(13083, "app/models/key.rb", opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.True(t, exists)
+	require.Equal(t, want, f)
+
+	exists, f, resp, err = client.RepositoryFiles.FileExists(13083, "app/models/missing.rb", opt)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.False(t, exists)
+	require.Nil(t, f)
+
+	exists, f, resp, err = client.RepositoryFiles.FileExists(13083.01, "app/models/key.rb", opt)
+	require.EqualError(t, err, "invalid ID type 13083.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.False(t, exists)
+	require.Nil(t, f)
+}
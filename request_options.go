@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"context"
+	"net/url"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -25,15 +26,47 @@ import (
 // RequestOptionFunc can be passed to all API requests to customize the API request.
 type RequestOptionFunc func(*retryablehttp.Request) error
 
-// WithContext runs the request with the provided context
+// WithContext runs the request with the provided context. Cancelling the
+// context, or reaching its deadline, aborts the request, and the returned
+// error wraps context.Canceled or context.DeadlineExceeded. Requests made
+// without this option use context.Background().
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
-		*req = *req.WithContext(ctx)
+		reqCtx := ctx
+		if isDryRun(req.Context()) {
+			// Keep a dry run a dry run, whatever the order of the options.
+			reqCtx = context.WithValue(reqCtx, dryRunContextKey{}, true)
+		}
+		*req = *req.WithContext(reqCtx)
 		return nil
 	}
 }
 
+// dryRunContextKey is the context key used to mark a request as a dry run.
+type dryRunContextKey struct{}
+
+// WithDryRun turns a request that would change data, like POST, PUT or
+// DELETE, into a dry run: the request is built and validated as usual, but
+// never sent. Instead, the returned Response has DryRun set and carries the
+// request that would have been sent in its Request field, including its
+// body. GET and HEAD requests are still sent.
+func WithDryRun() RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), dryRunContextKey{}, true))
+		return nil
+	}
+}
+
+// isDryRun reports whether ctx belongs to a request made WithDryRun.
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunContextKey{}).(bool)
+	return dryRun
+}
+
 // WithHeader takes a header name and value and appends it to the request headers.
+// Headers with different names accumulate, while setting the same name twice
+// keeps the last value. The authentication header of the client is only
+// replaced when its name is given explicitly; use WithToken for that instead.
 func WithHeader(name, value string) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
 		req.Header.Set(name, value)
@@ -52,6 +85,29 @@ func WithHeaders(headers map[string]string) RequestOptionFunc {
 	}
 }
 
+// WithQueryParam takes a query parameter name and value and adds it to the
+// request URL. Any parameters that are already set, for example by the
+// options struct of the request, are kept.
+func WithQueryParam(key, value string) RequestOptionFunc {
+	return WithQueryParams(url.Values{key: []string{value}})
+}
+
+// WithQueryParams takes a set of query parameters and adds them to the
+// request URL. Any parameters that are already set, for example by the
+// options struct of the request, are kept.
+func WithQueryParams(params url.Values) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		for k, vs := range params {
+			for _, v := range vs {
+				q.Add(k, v)
+			}
+		}
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
 // WithSudo takes either a username or user ID and sets the SUDO request header.
 func WithSudo(uid interface{}) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -14,12 +14,18 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWithHeader(t *testing.T) {
@@ -161,3 +167,145 @@ func TestWithHeaders(t *testing.T) {
 	_, err = client.Do(req, nil)
 	assert.NoError(t, err)
 }
+
+func TestWithHeaderAccumulatesAndKeepsAuth(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("token", WithBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+		assert.Equal(t, "eu-west", r.Header.Get("X-Route"))
+		assert.Equal(t, "gitaly-2", r.Header.Get("X-Shard"))
+		assert.Equal(t, "jdoe", r.Header.Get("SUDO"))
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	options := []RequestOptionFunc{
+		WithHeader("X-Route", "us-east"),
+		WithHeader("X-Shard", "gitaly-2"),
+		WithHeader("X-Route", "eu-west"),
+		WithSudo("jdoe"),
+	}
+
+	req, err := client.NewRequest(http.MethodGet, "project_repository_storage_moves/1", nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eu-west"}, req.Header.Values("X-Route"))
+	assert.Equal(t, "gitaly-2", req.Header.Get("X-Shard"))
+	assert.Equal(t, "jdoe", req.Header.Get("SUDO"))
+
+	_, _, err = client.ProjectRepositoryStorageMove.GetStorageMove(1, options...)
+	assert.NoError(t, err)
+}
+
+func TestWithQueryParam(t *testing.T) {
+	_, client := setup(t)
+
+	opt := &ListProjectsOptions{Search: String("gitlab")}
+
+	req, err := client.NewRequest(http.MethodGet, "projects", opt, []RequestOptionFunc{
+		WithQueryParam("experimental", "true"),
+		WithQueryParam("search", "other"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "experimental=true&search=gitlab&search=other", req.URL.RawQuery)
+	assert.Equal(t, "gitlab", req.URL.Query().Get("search"))
+}
+
+func TestWithQueryParams(t *testing.T) {
+	_, client := setup(t)
+
+	req, err := client.NewRequest(http.MethodGet, "projects", nil, []RequestOptionFunc{
+		WithQueryParams(url.Values{"preview": {"a", "b"}, "with_custom_attributes": {"true"}}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "preview=a&preview=b&with_custom_attributes=true", req.URL.RawQuery)
+}
+
+func TestWithContextCancelledMidFlight(t *testing.T) {
+	mux, client := setup(t)
+
+	started := make(chan struct{})
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(ctx))
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled), "expected context.Canceled, got %v", err)
+}
+
+func TestWithContextDeadlineExceeded(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(ctx))
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "expected context.DeadlineExceeded, got %v", err)
+}
+
+func TestWithoutContextUsesBackground(t *testing.T) {
+	_, client := setup(t)
+
+	req, err := client.NewRequest(http.MethodGet, "projects", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, context.Background(), req.Context())
+}
+
+func TestWithDryRun(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request for %s during a dry run", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id": 1, "state": "finished"}`)
+	})
+
+	resp, err := client.ProjectRepositoryStorageMove.ScheduleAllStorageMoves(&ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	}, WithDryRun())
+	require.NoError(t, err)
+	require.True(t, resp.DryRun)
+	require.Equal(t, http.MethodPost, resp.Request.Method)
+	require.Equal(t, client.BaseURL().String()+"project_repository_storage_moves", resp.Request.URL.String())
+
+	body, err := io.ReadAll(resp.Request.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"source_storage_name": "default", "destination_storage_name": "storage2"}`, string(body))
+
+	// The dry run survives a context given after it.
+	_, resp, err = client.ProjectRepositoryStorageMove.ScheduleStorageMoveForProject(5, nil,
+		WithDryRun(), WithContext(context.Background()), WithSudo("admin"))
+	require.NoError(t, err)
+	require.True(t, resp.DryRun)
+	require.Equal(t, client.BaseURL().String()+"projects/5/repository_storage_moves", resp.Request.URL.String())
+	require.Equal(t, "admin", resp.Request.Header.Get("SUDO"))
+
+	// Options are still validated.
+	_, err = client.ProjectRepositoryStorageMove.ScheduleAllStorageMoves(nil, WithDryRun(), WithSudo(1.5))
+	require.Error(t, err)
+
+	// Reading requests are sent as usual.
+	psm, resp, err := client.ProjectRepositoryStorageMove.GetStorageMove(1, WithDryRun())
+	require.NoError(t, err)
+	require.False(t, resp.DryRun)
+	require.Equal(t, StorageMoveFinished, psm.State)
+}
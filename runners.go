@@ -359,6 +359,7 @@ type ListGroupsRunnersOptions struct {
 	ListOptions
 	Type    *string   `url:"type,omitempty" json:"type,omitempty"`
 	Status  *string   `url:"status,omitempty" json:"status,omitempty"`
+	Paused  *bool     `url:"paused,omitempty" json:"paused,omitempty"`
 	TagList *[]string `url:"tag_list,comma,omitempty" json:"tag_list,omitempty"`
 }
 
@@ -423,6 +424,9 @@ type RegisterNewRunnerInfoOptions struct {
 
 // RegisterNewRunner registers a new Runner for the instance.
 //
+// Deprecated: Runner registration tokens are deprecated in GitLab 15.6,
+// use CreateRunner instead.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/runners.html#register-a-new-runner
 func (s *RunnersService) RegisterNewRunner(opt *RegisterNewRunnerOptions, options ...RequestOptionFunc) (*Runner, *Response, error) {
@@ -440,6 +444,44 @@ func (s *RunnersService) RegisterNewRunner(opt *RegisterNewRunnerOptions, option
 	return r, resp, nil
 }
 
+// CreateRunnerOptions represents the available CreateRunner() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner
+type CreateRunnerOptions struct {
+	RunnerType      *string   `url:"runner_type,omitempty" json:"runner_type,omitempty"`
+	GroupID         *int      `url:"group_id,omitempty" json:"group_id,omitempty"`
+	ProjectID       *int      `url:"project_id,omitempty" json:"project_id,omitempty"`
+	Description     *string   `url:"description,omitempty" json:"description,omitempty"`
+	Paused          *bool     `url:"paused,omitempty" json:"paused,omitempty"`
+	Locked          *bool     `url:"locked,omitempty" json:"locked,omitempty"`
+	RunUntagged     *bool     `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	TagList         *[]string `url:"tag_list[],omitempty" json:"tag_list,omitempty"`
+	AccessLevel     *string   `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout  *int      `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+	MaintenanceNote *string   `url:"maintenance_note,omitempty" json:"maintenance_note,omitempty"`
+}
+
+// CreateRunner creates a runner linked to the current user. The returned
+// runner contains the runner authentication token, which is only shown once.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#create-a-runner
+func (s *RunnersService) CreateRunner(opt *CreateRunnerOptions, options ...RequestOptionFunc) (*Runner, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/runners", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Runner)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
 // DeleteRegisteredRunnerOptions represents the available
 // DeleteRegisteredRunner() options.
 //
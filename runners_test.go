@@ -38,15 +38,109 @@ func TestDisableRunner(t *testing.T) {
 	}
 }
 
+func TestEnableProjectRunner(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"runner_id":2}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 2, "description": "test-2", "is_shared": false}`)
+	})
+
+	runner, _, err := client.Runners.EnableProjectRunner(1, &EnableProjectRunnerOptions{RunnerID: 2})
+	if err != nil {
+		t.Fatalf("Runners.EnableProjectRunner returns an error: %v", err)
+	}
+
+	want := &Runner{ID: 2, Description: "test-2"}
+	if !reflect.DeepEqual(want, runner) {
+		t.Errorf("Runners.EnableProjectRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestListProjectRunners(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "type=project_type")
+		fmt.Fprint(w, `[{"id": 2, "runner_type": "project_type"}]`)
+	})
+
+	runners, _, err := client.Runners.ListProjectRunners(1, &ListProjectRunnersOptions{Type: String("project_type")})
+	if err != nil {
+		t.Fatalf("Runners.ListProjectRunners returns an error: %v", err)
+	}
+
+	want := []*Runner{{ID: 2, RunnerType: "project_type"}}
+	if !reflect.DeepEqual(want, runners) {
+		t.Errorf("Runners.ListProjectRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestListRunnersWithFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "paused=false&status=online&tag_list=docker%2Clinux&type=group_type")
+		fmt.Fprint(w, `[{"id": 1, "runner_type": "group_type", "status": "online"}]`)
+	})
+
+	opt := &ListRunnersOptions{
+		Type:    String("group_type"),
+		Status:  String("online"),
+		Paused:  Bool(false),
+		TagList: &[]string{"docker", "linux"},
+	}
+
+	runners, _, err := client.Runners.ListRunners(opt)
+	if err != nil {
+		t.Fatalf("Runners.ListRunners returns an error: %v", err)
+	}
+
+	want := []*Runner{{ID: 1, RunnerType: "group_type", Status: "online"}}
+	if !reflect.DeepEqual(want, runners) {
+		t.Errorf("Runners.ListRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestListGroupsRunnersWithFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/groups/1/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "paused=true&status=stale")
+		fmt.Fprint(w, `[{"id": 2, "paused": true, "status": "stale"}]`)
+	})
+
+	opt := &ListGroupsRunnersOptions{
+		Status: String("stale"),
+		Paused: Bool(true),
+	}
+
+	runners, _, err := client.Runners.ListGroupsRunners(1, opt)
+	if err != nil {
+		t.Fatalf("Runners.ListGroupsRunners returns an error: %v", err)
+	}
+
+	want := []*Runner{{ID: 2, Paused: true, Status: "stale"}}
+	if !reflect.DeepEqual(want, runners) {
+		t.Errorf("Runners.ListGroupsRunners returned %+v, want %+v", runners, want)
+	}
+}
+
 func TestListRunnersJobs(t *testing.T) {
 	mux, client := setup(t)
 
 	mux.HandleFunc("/api/v4/runners/1/jobs", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "status=failed")
 		fmt.Fprint(w, exampleListRunnerJobs)
 	})
 
-	opt := &ListRunnerJobsOptions{}
+	opt := &ListRunnerJobsOptions{Status: String("failed")}
 
 	jobs, _, err := client.Runners.ListRunnerJobs(1, opt)
 	if err != nil {
@@ -328,6 +422,38 @@ func TestRegisterNewRunnerInfo(t *testing.T) {
 	}
 }
 
+func TestCreateRunner(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"runner_type":"project_type","project_id":42,"description":"autoscaler","tag_list":["docker"]}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 9171, "token": "glrt-kyahzxLaj4Dc1jQf4xjX", "token_expires_at": null}`)
+	})
+
+	opt := &CreateRunnerOptions{
+		RunnerType:  String("project_type"),
+		ProjectID:   Int(42),
+		Description: String("autoscaler"),
+		TagList:     &[]string{"docker"},
+	}
+
+	runner, resp, err := client.Runners.CreateRunner(opt)
+	if err != nil {
+		t.Fatalf("Runners.CreateRunner returns an error: %v", err)
+	}
+
+	want := &Runner{ID: 9171, Token: "glrt-kyahzxLaj4Dc1jQf4xjX"}
+	if !reflect.DeepEqual(want, runner) {
+		t.Errorf("Runners.CreateRunner returned %+v, want %+v", runner, want)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Runners.CreateRunner returned status code %+v, want %+v", resp.StatusCode, http.StatusCreated)
+	}
+}
+
 func TestDeleteRegisteredRunner(t *testing.T) {
 	mux, client := setup(t)
 
@@ -0,0 +1,106 @@
+//go:build go1.23
+
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"iter"
+	"net/url"
+	"strconv"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// Scan returns an iterator over all items of a paginated list endpoint. The
+// fn callback is called once per page and must pass the given
+// RequestOptionFunc on to the underlying list method, which selects the page
+// to retrieve for both offset-based and keyset-based pagination. Pages are
+// fetched lazily as the iterator advances, so only one page is held in
+// memory at a time.
+//
+// Iteration stops after the first error, which is yielded together with a
+// nil item.
+//
+// Example:
+//
+//	for move, err := range gitlab.Scan(func(p gitlab.RequestOptionFunc) ([]*gitlab.ProjectRepositoryStorageMove, *gitlab.Response, error) {
+//		return client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(opt, p)
+//	}) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(move.ID)
+//	}
+func Scan[T any](fn func(p RequestOptionFunc) ([]*T, *Response, error)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		next := func(*retryablehttp.Request) error { return nil }
+
+		for {
+			items, resp, err := fn(next)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			switch {
+			case resp.NextPage != 0:
+				next = withPage(resp.NextPage)
+			case resp.NextLink != "":
+				next = withNextLink(resp.NextLink)
+			default:
+				return
+			}
+		}
+	}
+}
+
+// withPage sets the page query parameter of the request, replacing any page
+// already set by the options struct of the request.
+func withPage(page int) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("page", strconv.Itoa(page))
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// withNextLink sets the query parameters of the request to the ones of a
+// keyset pagination link, replacing any parameters with the same name that
+// are already set by the options struct of the request.
+func withNextLink(link string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		u, err := url.Parse(link)
+		if err != nil {
+			return err
+		}
+
+		q := req.URL.Query()
+		for k, vs := range u.Query() {
+			q[k] = vs
+		}
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+//go:build go1.23
+
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanOffsetPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testParams(t, r, "page=1&per_page=2")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+		case "2":
+			testParams(t, r, "page=2&per_page=2")
+			w.Header().Set("X-Next-Page", "3")
+			fmt.Fprint(w, `[{"id": 3}, {"id": 4}]`)
+		case "3":
+			testParams(t, r, "page=3&per_page=2")
+			fmt.Fprint(w, `[{"id": 5}]`)
+		default:
+			t.Fatalf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	opts := RetrieveAllStorageMovesOptions{ListOptions: ListOptions{Page: 1, PerPage: 2}}
+
+	var ids []int
+	for move, err := range Scan(func(p RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+		return client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(opts, p)
+	}) {
+		require.NoError(t, err)
+		ids = append(ids, move.ID)
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+}
+
+func TestScanKeysetPagination(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			testParams(t, r, "pagination=keyset&per_page=2")
+			w.Header().Set("Link", `<https://gitlab.example.com/api/v4/project_repository_storage_moves?cursor=c1&pagination=keyset&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+		case "c1":
+			testParams(t, r, "cursor=c1&pagination=keyset&per_page=2")
+			w.Header().Set("Link", `<https://gitlab.example.com/api/v4/project_repository_storage_moves?cursor=c2&pagination=keyset&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id": 3}, {"id": 4}]`)
+		case "c2":
+			testParams(t, r, "cursor=c2&pagination=keyset&per_page=2")
+			fmt.Fprint(w, `[{"id": 5}]`)
+		default:
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+
+	opts := RetrieveAllStorageMovesOptions{ListOptions: ListOptions{PerPage: 2, Pagination: "keyset"}}
+
+	var ids []int
+	for move, err := range Scan(func(p RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+		return client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(opts, p)
+	}) {
+		require.NoError(t, err)
+		ids = append(ids, move.ID)
+	}
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+}
+
+func TestScanStopsOnError(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	var ids []int
+	var errs []error
+	for move, err := range Scan(func(p RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+		return client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(RetrieveAllStorageMovesOptions{}, p)
+	}) {
+		if err != nil {
+			require.Nil(t, move)
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, move.ID)
+	}
+
+	require.Equal(t, []int{1}, ids)
+	require.Len(t, errs, 1)
+}
+
+func TestScanBreakStopsFetching(t *testing.T) {
+	mux, client := setup(t)
+
+	requests := 0
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		w.Header().Set("X-Next-Page", "2")
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+
+	for move, err := range Scan(func(p RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+		return client.ProjectRepositoryStorageMove.RetrieveAllStorageMoves(RetrieveAllStorageMovesOptions{}, p)
+	}) {
+		require.NoError(t, err)
+		if move.ID == 1 {
+			break
+		}
+	}
+
+	require.Equal(t, 1, requests)
+}
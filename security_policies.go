@@ -0,0 +1,111 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SecurityPoliciesService handles communication with the security policies
+// related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+type SecurityPoliciesService struct {
+	client *Client
+}
+
+// SecurityPolicies represents the security policies enforced on a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+type SecurityPolicies struct {
+	PolicyProject         *BasicProject     `json:"policy_project"`
+	ScanExecutionPolicies []*SecurityPolicy `json:"scan_execution_policies"`
+	ScanResultPolicies    []*SecurityPolicy `json:"scan_result_policies"`
+}
+
+// SecurityPolicy represents a single scan execution or scan result policy.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+type SecurityPolicy struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Enabled     bool       `json:"enabled"`
+	YAML        string     `json:"yaml"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+func (p SecurityPolicy) String() string {
+	return Stringify(p)
+}
+
+// GetSecurityPolicies gets the security policy project linked to a project,
+// together with the scan execution and scan result policies it enforces.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/
+func (s *SecurityPoliciesService) GetSecurityPolicies(pid interface{}, options ...RequestOptionFunc) (*SecurityPolicies, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/security_policies", PathEscape(project))
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sp := new(SecurityPolicies)
+	resp, err := s.client.Do(req, sp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sp, resp, nil
+}
+
+// ListScanExecutionPolicies gets the scan execution policies enforced on a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/scan-execution-policies.html
+func (s *SecurityPoliciesService) ListScanExecutionPolicies(pid interface{}, options ...RequestOptionFunc) ([]*SecurityPolicy, *Response, error) {
+	sp, resp, err := s.GetSecurityPolicies(pid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sp.ScanExecutionPolicies, resp, nil
+}
+
+// ListScanResultPolicies gets the scan result policies enforced on a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/application_security/policies/scan-result-policies.html
+func (s *SecurityPoliciesService) ListScanResultPolicies(pid interface{}, options ...RequestOptionFunc) ([]*SecurityPolicy, *Response, error) {
+	sp, resp, err := s.GetSecurityPolicies(pid, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sp.ScanResultPolicies, resp, nil
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2023, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityPoliciesService_GetSecurityPolicies(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/security_policies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+			{
+			  "policy_project": {"id": 2, "path_with_namespace": "group/project-security-policy-project"},
+			  "scan_execution_policies": [
+				{"name": "Run DAST", "enabled": true, "yaml": "name: Run DAST\n"}
+			  ],
+			  "scan_result_policies": [
+				{"name": "Require approval", "enabled": false, "yaml": "name: Require approval\n"}
+			  ]
+			}
+		`)
+	})
+
+	want := &SecurityPolicies{
+		PolicyProject: &BasicProject{ID: 2, PathWithNamespace: "group/project-security-policy-project"},
+		ScanExecutionPolicies: []*SecurityPolicy{
+			{Name: "Run DAST", Enabled: true, YAML: "name: Run DAST\n"},
+		},
+		ScanResultPolicies: []*SecurityPolicy{
+			{Name: "Require approval", Enabled: false, YAML: "name: Require approval\n"},
+		},
+	}
+
+	sp, resp, err := client.SecurityPolicies.GetSecurityPolicies(1)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, sp)
+
+	sep, _, err := client.SecurityPolicies.ListScanExecutionPolicies(1)
+	require.NoError(t, err)
+	require.Equal(t, want.ScanExecutionPolicies, sep)
+
+	srp, _, err := client.SecurityPolicies.ListScanResultPolicies(1)
+	require.NoError(t, err)
+	require.Equal(t, want.ScanResultPolicies, srp)
+
+	sp, resp, err = client.SecurityPolicies.GetSecurityPolicies(1.01)
+	require.EqualError(t, err, "invalid ID type 1.01, the ID must be an int or a string")
+	require.Nil(t, resp)
+	require.Nil(t, sp)
+
+	sp, resp, err = client.SecurityPolicies.GetSecurityPolicies(3)
+	require.Error(t, err)
+	require.Nil(t, sp)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -860,12 +860,14 @@ type JiraService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/services.html#jira
 type JiraServiceProperties struct {
-	URL                   string `json:"url"`
-	APIURL                string `json:"api_url"`
-	ProjectKey            string `json:"project_key" `
-	Username              string `json:"username" `
-	Password              string `json:"password" `
-	JiraIssueTransitionID string `json:"jira_issue_transition_id"`
+	URL                   string   `json:"url"`
+	APIURL                string   `json:"api_url"`
+	ProjectKey            string   `json:"project_key" `
+	Username              string   `json:"username" `
+	Password              string   `json:"password" `
+	JiraAuthType          int      `json:"jira_auth_type"`
+	JiraIssueTransitionID string   `json:"jira_issue_transition_id"`
+	ProjectKeys           []string `json:"project_keys"`
 }
 
 // UnmarshalJSON decodes the Jira Service Properties.
@@ -924,21 +926,23 @@ func (s *ServicesService) GetJiraService(pid interface{}, options ...RequestOpti
 }
 
 // SetJiraServiceOptions represents the available SetJiraService()
-// options.
+// options. When using Jira Cloud, Password should be set to an API token.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/services.html#edit-jira-service
 type SetJiraServiceOptions struct {
-	URL                   *string `url:"url,omitempty" json:"url,omitempty"`
-	APIURL                *string `url:"api_url,omitempty" json:"api_url,omitempty"`
-	ProjectKey            *string `url:"project_key,omitempty" json:"project_key,omitempty" `
-	Username              *string `url:"username,omitempty" json:"username,omitempty" `
-	Password              *string `url:"password,omitempty" json:"password,omitempty" `
-	Active                *bool   `url:"active,omitempty" json:"active,omitempty"`
-	JiraIssueTransitionID *string `url:"jira_issue_transition_id,omitempty" json:"jira_issue_transition_id,omitempty"`
-	CommitEvents          *bool   `url:"commit_events,omitempty" json:"commit_events,omitempty"`
-	MergeRequestsEvents   *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	CommentOnEventEnabled *bool   `url:"comment_on_event_enabled,omitempty" json:"comment_on_event_enabled,omitempty"`
+	URL                   *string   `url:"url,omitempty" json:"url,omitempty"`
+	APIURL                *string   `url:"api_url,omitempty" json:"api_url,omitempty"`
+	ProjectKey            *string   `url:"project_key,omitempty" json:"project_key,omitempty" `
+	ProjectKeys           *[]string `url:"project_keys,comma,omitempty" json:"project_keys,omitempty"`
+	Username              *string   `url:"username,omitempty" json:"username,omitempty" `
+	Password              *string   `url:"password,omitempty" json:"password,omitempty" `
+	JiraAuthType          *int      `url:"jira_auth_type,omitempty" json:"jira_auth_type,omitempty"`
+	Active                *bool     `url:"active,omitempty" json:"active,omitempty"`
+	JiraIssueTransitionID *string   `url:"jira_issue_transition_id,omitempty" json:"jira_issue_transition_id,omitempty"`
+	CommitEvents          *bool     `url:"commit_events,omitempty" json:"commit_events,omitempty"`
+	MergeRequestsEvents   *bool     `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	CommentOnEventEnabled *bool     `url:"comment_on_event_enabled,omitempty" json:"comment_on_event_enabled,omitempty"`
 }
 
 // SetJiraService sets Jira service for a project
@@ -1591,19 +1595,25 @@ type SetSlackSlashCommandsServiceOptions struct {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/13.12/ee/api/services.html#createedit-slack-slash-command-service
-func (s *ServicesService) SetSlackSlashCommandsService(pid interface{}, opt *SetSlackSlashCommandsServiceOptions, options ...RequestOptionFunc) (*Response, error) {
+func (s *ServicesService) SetSlackSlashCommandsService(pid interface{}, opt *SetSlackSlashCommandsServiceOptions, options ...RequestOptionFunc) (*SlackSlashCommandsService, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/services/slack-slash-commands", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	svc := new(SlackSlashCommandsService)
+	resp, err := s.client.Do(req, svc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return svc, resp, nil
 }
 
 // DeleteSlackSlashCommandsService deletes Slack slash commands service for project.
@@ -1668,7 +1678,7 @@ func (s *ServicesService) GetMattermostSlashCommandsService(pid interface{}, opt
 	return svc, resp, nil
 }
 
-// SetMattermostSlashCommandsServiceOptions represents the available SetSlackSlashCommandsService()
+// SetMattermostSlashCommandsServiceOptions represents the available SetMattermostSlashCommandsService()
 // options.
 //
 // GitLab API docs:
@@ -1682,19 +1692,25 @@ type SetMattermostSlashCommandsServiceOptions struct {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/integrations.html#createedit-mattermost-slash-command-integration
-func (s *ServicesService) SetMattermostSlashCommandsService(pid interface{}, opt *SetMattermostSlashCommandsServiceOptions, options ...RequestOptionFunc) (*Response, error) {
+func (s *ServicesService) SetMattermostSlashCommandsService(pid interface{}, opt *SetMattermostSlashCommandsServiceOptions, options ...RequestOptionFunc) (*MattermostSlashCommandsService, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/services/mattermost-slash-commands", PathEscape(project))
 
 	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	svc := new(MattermostSlashCommandsService)
+	resp, err := s.client.Do(req, svc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return svc, resp, nil
 }
 
 // DeleteMattermostSlashCommandsService deletes Mattermost slash commands service for project.
@@ -268,6 +268,11 @@ func TestGetJiraService(t *testing.T) {
 		fmt.Fprint(w, `{"id":1, "properties": {}}`)
 	})
 
+	mux.HandleFunc("/api/v4/projects/4/services/jira", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1, "properties": {"jira_auth_type": 1, "project_keys": ["ABC", "DEF"]}}`)
+	})
+
 	want := []*JiraService{
 		{
 			Service: Service{ID: 1},
@@ -291,6 +296,13 @@ func TestGetJiraService(t *testing.T) {
 			Service:    Service{ID: 1},
 			Properties: &JiraServiceProperties{},
 		},
+		{
+			Service: Service{ID: 1},
+			Properties: &JiraServiceProperties{
+				JiraAuthType: 1,
+				ProjectKeys:  []string{"ABC", "DEF"},
+			},
+		},
 	}
 
 	for testcase := 0; testcase < len(want); testcase++ {
@@ -310,14 +322,17 @@ func TestSetJiraService(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/services/jira", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"url":"asd","api_url":"asd","project_key":"as","project_keys":["AS","QA"],"username":"aas","password":"asd","jira_auth_type":1,"active":true,"jira_issue_transition_id":"2,3","commit_events":true,"merge_requests_events":true,"comment_on_event_enabled":true}`)
 	})
 
 	opt := &SetJiraServiceOptions{
 		URL:                   String("asd"),
 		APIURL:                String("asd"),
 		ProjectKey:            String("as"),
+		ProjectKeys:           &[]string{"AS", "QA"},
 		Username:              String("aas"),
 		Password:              String("asd"),
+		JiraAuthType:          Int(1),
 		Active:                Bool(true),
 		JiraIssueTransitionID: String("2,3"),
 		CommitEvents:          Bool(true),
@@ -617,16 +632,25 @@ func TestSetSlackSlashCommandsService(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/services/slack-slash-commands", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"token":"token"}`)
+		fmt.Fprint(w, `{"id":1, "active":true, "properties": {"token":"token"}}`)
 	})
+	want := &SlackSlashCommandsService{
+		Service:    Service{ID: 1, Active: true},
+		Properties: &SlackSlashCommandsProperties{Token: "token"},
+	}
 
 	opt := &SetSlackSlashCommandsServiceOptions{
 		Token: String("token"),
 	}
 
-	_, err := client.Services.SetSlackSlashCommandsService(1, opt)
+	service, _, err := client.Services.SetSlackSlashCommandsService(1, opt)
 	if err != nil {
 		t.Fatalf("Services.SetSlackSlashCommandsService returns an error: %v", err)
 	}
+	if !reflect.DeepEqual(want, service) {
+		t.Errorf("Services.SetSlackSlashCommandsService returned %+v, want %+v", service, want)
+	}
 }
 
 func TestDeleteSlackSlashCommandsService(t *testing.T) {
@@ -665,17 +689,26 @@ func TestSetMattermostSlashCommandsService(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/services/mattermost-slash-commands", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"token":"token","username":"username"}`)
+		fmt.Fprint(w, `{"id":1, "active":true, "properties": {"token":"token", "username":"username"}}`)
 	})
+	want := &MattermostSlashCommandsService{
+		Service:    Service{ID: 1, Active: true},
+		Properties: &MattermostSlashCommandsProperties{Token: "token", Username: "username"},
+	}
 
 	opt := &SetMattermostSlashCommandsServiceOptions{
 		Token:    String("token"),
 		Username: String("username"),
 	}
 
-	_, err := client.Services.SetMattermostSlashCommandsService(1, opt)
+	service, _, err := client.Services.SetMattermostSlashCommandsService(1, opt)
 	if err != nil {
 		t.Fatalf("Services.SetMattermostSlashCommandsService returns an error: %v", err)
 	}
+	if !reflect.DeepEqual(want, service) {
+		t.Errorf("Services.SetMattermostSlashCommandsService returned %+v, want %+v", service, want)
+	}
 }
 
 func TestDeleteMattermostSlashCommandsService(t *testing.T) {
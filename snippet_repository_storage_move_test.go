@@ -0,0 +1,179 @@
+//
+// Copyright 2023, Nick Westbury
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnippetRepositoryStorageMoveService_Retrieve(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		retrieve func(c *Client, options ...RequestOptionFunc) ([]*SnippetRepositoryStorageMove, *Response, error)
+	}{
+		{
+			name: "all",
+			path: "/api/v4/snippet_repository_storage_moves",
+			retrieve: func(c *Client, options ...RequestOptionFunc) ([]*SnippetRepositoryStorageMove, *Response, error) {
+				return c.SnippetRepositoryStorageMove.RetrieveAllStorageMoves(RetrieveAllStorageMovesOptions{ListOptions: ListOptions{Page: 1, PerPage: 2}}, options...)
+			},
+		},
+		{
+			name: "for snippet",
+			path: "/api/v4/snippets/65/repository_storage_moves",
+			retrieve: func(c *Client, options ...RequestOptionFunc) ([]*SnippetRepositoryStorageMove, *Response, error) {
+				return c.SnippetRepositoryStorageMove.RetrieveAllStorageMovesForSnippet(65, RetrieveAllStorageMovesOptions{ListOptions: ListOptions{Page: 1, PerPage: 2}}, options...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, client := setup(t)
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodGet)
+				testParams(t, r, "page=1&per_page=2")
+				fmt.Fprint(w, `[{"id": 1, "state": "finished", "snippet": {"id": 65, "title": "Test Snippet"}}]`)
+			})
+
+			ssms, resp, err := tt.retrieve(client)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Len(t, ssms, 1)
+			require.Equal(t, 65, ssms[0].Snippet.ID)
+			require.Equal(t, "Test Snippet", ssms[0].Snippet.Title)
+
+			ssms, resp, err = tt.retrieve(client, errorOption)
+			require.EqualError(t, err, "RequestOptionFunc returns an error")
+			require.Nil(t, resp)
+			require.Nil(t, ssms)
+		})
+	}
+}
+
+func TestSnippetRepositoryStorageMoveService_Get(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		get  func(c *Client, id int) (*SnippetRepositoryStorageMove, *Response, error)
+	}{
+		{
+			name: "by id",
+			path: "/api/v4/snippet_repository_storage_moves/123",
+			get: func(c *Client, id int) (*SnippetRepositoryStorageMove, *Response, error) {
+				return c.SnippetRepositoryStorageMove.GetStorageMove(id)
+			},
+		},
+		{
+			name: "for snippet",
+			path: "/api/v4/snippets/65/repository_storage_moves/123",
+			get: func(c *Client, id int) (*SnippetRepositoryStorageMove, *Response, error) {
+				return c.SnippetRepositoryStorageMove.GetStorageMoveForSnippet(65, id)
+			},
+		},
+	}
+
+	want := &SnippetRepositoryStorageMove{
+		ID:                     123,
+		State:                  StorageMoveStarted,
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Snippet: StorageMoveSnippet{
+			ID:     65,
+			WebURL: "https://gitlab.example.com/snippets/65",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux, client := setup(t)
+
+			mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, http.MethodGet)
+				fmt.Fprint(w, `{"id": 123, "state": "started", "source_storage_name": "default", "destination_storage_name": "storage2", "snippet": {"id": 65, "web_url": "https://gitlab.example.com/snippets/65"}}`)
+			})
+
+			ssm, resp, err := tt.get(client, 123)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, want, ssm)
+
+			ssm, resp, err = tt.get(client, 124)
+			require.Error(t, err)
+			require.Nil(t, ssm)
+			require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		})
+	}
+}
+
+func TestSnippetRepositoryStorageMoveService_ScheduleAllStorageMoves(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/snippet_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"source_storage_name":"default","destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message": "202 Accepted"}`)
+	})
+
+	opts := &ScheduleAllStorageMovesOptions{
+		SourceStorageName:      String("default"),
+		DestinationStorageName: String("storage2"),
+	}
+
+	resp, err := client.SnippetRepositoryStorageMove.ScheduleAllStorageMoves(opts)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, err = client.SnippetRepositoryStorageMove.ScheduleAllStorageMoves(opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+}
+
+func TestSnippetRepositoryStorageMoveService_ScheduleStorageMoveForSnippet(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/snippets/65/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		fmt.Fprint(w, `{"id": 123, "state": "scheduled", "destination_storage_name": "storage2", "snippet": {"id": 65}}`)
+	})
+
+	opts := &ScheduleStorageMoveForSnippetOptions{DestinationStorageName: String("storage2")}
+
+	ssm, resp, err := client.SnippetRepositoryStorageMove.ScheduleStorageMoveForSnippet(65, opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, StorageMoveScheduled, ssm.State)
+	require.Equal(t, 65, ssm.Snippet.ID)
+
+	ssm, resp, err = client.SnippetRepositoryStorageMove.ScheduleStorageMoveForSnippet(65, opts, errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, ssm)
+
+	ssm, resp, err = client.SnippetRepositoryStorageMove.ScheduleStorageMoveForSnippet(66, opts)
+	require.Error(t, err)
+	require.Nil(t, ssm)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
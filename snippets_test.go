@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -129,3 +130,25 @@ func TestSnippetsService_ExploreSnippets(t *testing.T) {
 	want := []*Snippet{{ID: 42, Title: "test"}}
 	require.Equal(t, want, ss)
 }
+
+func TestSnippetsService_ListAllSnippets(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/snippets/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "created_after=2023-01-01T00%3A00%3A00Z&page=1&per_page=10&repository_storage=default")
+		fmt.Fprint(w, `[{"id":42,"title":"test"},{"id":43,"title":"secret"}]`)
+	})
+
+	opt := &ListAllSnippetsOptions{
+		ListOptions:       ListOptions{Page: 1, PerPage: 10},
+		CreatedAfter:      Time(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		RepositoryStorage: String("default"),
+	}
+
+	ss, _, err := client.Snippets.ListAllSnippets(opt)
+	require.NoError(t, err)
+
+	want := []*Snippet{{ID: 42, Title: "test"}, {ID: 43, Title: "secret"}}
+	require.Equal(t, want, ss)
+}
@@ -65,6 +65,47 @@ func TestTagsService_ListTags(t *testing.T) {
 	}
 }
 
+func TestTagsService_ListTagsWithReleaseAndFilters(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "order_by=updated&search=%5Ev1&sort=desc")
+		fmt.Fprint(w, `[
+      {
+        "name": "v1.0.0",
+        "protected": true,
+        "release": {"tag_name": "v1.0.0", "description": "First release"}
+      }
+    ]`)
+	})
+
+	opt := &ListTagsOptions{
+		OrderBy: String("updated"),
+		Search:  String("^v1"),
+		Sort:    String("desc"),
+	}
+
+	tags, _, err := client.Tags.ListTags(1, opt)
+	if err != nil {
+		t.Errorf("Tags.ListTags returned error: %v", err)
+	}
+
+	want := []*Tag{
+		{
+			Name:      "v1.0.0",
+			Protected: true,
+			Release: &ReleaseNote{
+				TagName:     "v1.0.0",
+				Description: "First release",
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, tags) {
+		t.Errorf("Tags.ListTags returned %+v, want %+v", tags, want)
+	}
+}
+
 func TestTagsService_CreateReleaseNote(t *testing.T) {
 	mux, client := setup(t)
 
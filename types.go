@@ -249,6 +249,32 @@ func BuildState(v BuildStateValue) *BuildStateValue {
 	return p
 }
 
+// ClearStatusAfterValue represents the time after which a user status is
+// cleared within GitLab.
+type ClearStatusAfterValue string
+
+// List of available clear status after values.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#set-user-status
+const (
+	ClearStatusAfter30Minutes ClearStatusAfterValue = "30_minutes"
+	ClearStatusAfter3Hours    ClearStatusAfterValue = "3_hours"
+	ClearStatusAfter8Hours    ClearStatusAfterValue = "8_hours"
+	ClearStatusAfter1Day      ClearStatusAfterValue = "1_day"
+	ClearStatusAfter3Days     ClearStatusAfterValue = "3_days"
+	ClearStatusAfter7Days     ClearStatusAfterValue = "7_days"
+	ClearStatusAfter30Days    ClearStatusAfterValue = "30_days"
+)
+
+// ClearStatusAfter is a helper routine that allocates a new
+// ClearStatusAfterValue to store v and returns a pointer to it.
+func ClearStatusAfter(v ClearStatusAfterValue) *ClearStatusAfterValue {
+	p := new(ClearStatusAfterValue)
+	*p = v
+	return p
+}
+
 // DeploymentStatusValue represents a Gitlab deployment status.
 type DeploymentStatusValue string
 
@@ -418,6 +444,67 @@ func (t ISOTime) String() string {
 	return time.Time(t).Format(iso8601)
 }
 
+// ISODateTime represents an ISO 8601 formatted timestamp. Unlike time.Time,
+// which marshals with nanosecond precision, it is sent to GitLab with second
+// precision. Decoding accepts any RFC 3339 timestamp, including the
+// fractional seconds GitLab returns.
+type ISODateTime time.Time
+
+// ISO 8601 timestamp format
+const iso8601DateTime = "2006-01-02T15:04:05Z07:00"
+
+// ParseISODateTime parses an ISO 8601 formatted timestamp.
+func ParseISODateTime(s string) (ISODateTime, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return ISODateTime(t), err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t ISODateTime) MarshalJSON() ([]byte, error) {
+	if time.Time(t).IsZero() {
+		return []byte(`null`), nil
+	}
+
+	if y := time.Time(t).Year(); y < 0 || y >= 10000 {
+		// ISO 8901 uses 4 digits for the years.
+		return nil, errors.New("json: ISODateTime year outside of range [0,9999]")
+	}
+
+	b := make([]byte, 0, len(iso8601DateTime)+2)
+	b = append(b, '"')
+	b = time.Time(t).AppendFormat(b, iso8601DateTime)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *ISODateTime) UnmarshalJSON(data []byte) error {
+	// Ignore null, like in the main JSON package.
+	if string(data) == "null" {
+		return nil
+	}
+
+	isotime, err := time.Parse(`"`+time.RFC3339Nano+`"`, string(data))
+	*t = ISODateTime(isotime)
+
+	return err
+}
+
+// EncodeValues implements the query.Encoder interface.
+func (t *ISODateTime) EncodeValues(key string, v *url.Values) error {
+	if t == nil || (time.Time(*t)).IsZero() {
+		return nil
+	}
+	v.Add(key, t.String())
+	return nil
+}
+
+// String implements the Stringer interface.
+func (t ISODateTime) String() string {
+	return time.Time(t).Format(iso8601DateTime)
+}
+
 // LinkTypeValue represents a release link type.
 type LinkTypeValue string
 
@@ -594,6 +681,28 @@ func ProjectCreationLevel(v ProjectCreationLevelValue) *ProjectCreationLevelValu
 	return p
 }
 
+// ProtectionRuleAccessLevelValue represents the minimum access level a user
+// needs to perform an action guarded by a protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type ProtectionRuleAccessLevelValue string
+
+// List of available protection rule access levels.
+const (
+	ProtectionRuleAccessLevelMaintainer ProtectionRuleAccessLevelValue = "maintainer"
+	ProtectionRuleAccessLevelOwner      ProtectionRuleAccessLevelValue = "owner"
+	ProtectionRuleAccessLevelAdmin      ProtectionRuleAccessLevelValue = "admin"
+)
+
+// ProtectionRuleAccessLevel is a helper routine that allocates a new
+// ProtectionRuleAccessLevelValue to store v and returns a pointer to it.
+func ProtectionRuleAccessLevel(v ProtectionRuleAccessLevelValue) *ProtectionRuleAccessLevelValue {
+	p := new(ProtectionRuleAccessLevelValue)
+	*p = v
+	return p
+}
+
 // SharedRunnersSettingValue determines whether shared runners are enabled for a
 // group’s subgroups and projects.
 //
@@ -18,7 +18,9 @@ package gitlab
 
 import (
 	"encoding/json"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestBoolValue(t *testing.T) {
@@ -72,3 +74,116 @@ func TestBoolValue(t *testing.T) {
 		})
 	}
 }
+
+func TestISODateTimeMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		{
+			name:     "should marshal UTC with a Z suffix",
+			time:     time.Date(2023, 3, 14, 15, 9, 26, 0, time.UTC),
+			expected: `"2023-03-14T15:09:26Z"`,
+		},
+		{
+			name:     "should marshal an offset zone with its offset",
+			time:     time.Date(2023, 3, 14, 15, 9, 26, 0, time.FixedZone("CET", 3600)),
+			expected: `"2023-03-14T15:09:26+01:00"`,
+		},
+		{
+			name:     "should drop fractional seconds",
+			time:     time.Date(2023, 3, 14, 15, 9, 26, 535897932, time.UTC),
+			expected: `"2023-03-14T15:09:26Z"`,
+		},
+		{
+			name:     "should marshal the zero time as null",
+			expected: `null`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := json.Marshal(ISODateTime(testCase.time))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(b) != testCase.expected {
+				t.Fatalf("Expected %s but got %s", testCase.expected, b)
+			}
+		})
+	}
+}
+
+func TestISODateTimeUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected time.Time
+	}{
+		{
+			name:     "should unmarshal UTC",
+			data:     `"2023-03-14T15:09:26Z"`,
+			expected: time.Date(2023, 3, 14, 15, 9, 26, 0, time.UTC),
+		},
+		{
+			name:     "should unmarshal an offset zone",
+			data:     `"2023-03-14T16:09:26+01:00"`,
+			expected: time.Date(2023, 3, 14, 15, 9, 26, 0, time.UTC),
+		},
+		{
+			name:     "should unmarshal fractional seconds",
+			data:     `"2023-03-14T15:09:26.535Z"`,
+			expected: time.Date(2023, 3, 14, 15, 9, 26, 535000000, time.UTC),
+		},
+		{
+			name: "should ignore null",
+			data: `null`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var d ISODateTime
+			if err := json.Unmarshal([]byte(testCase.data), &d); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !time.Time(d).Equal(testCase.expected) {
+				t.Fatalf("Expected %v but got %v", testCase.expected, time.Time(d))
+			}
+		})
+	}
+
+	var d ISODateTime
+	if err := json.Unmarshal([]byte(`"2023-03-14"`), &d); err == nil {
+		t.Fatal("Expected an error for a date without a time")
+	}
+}
+
+func TestISODateTimeRoundTrip(t *testing.T) {
+	want := ISODateTime(time.Date(2023, 12, 31, 23, 59, 59, 0, time.FixedZone("", -5*3600)))
+
+	b, err := json.Marshal(&want)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var got ISODateTime
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !time.Time(got).Equal(time.Time(want)) || got.String() != "2023-12-31T23:59:59-05:00" {
+		t.Fatalf("Expected %v but got %v", want, got)
+	}
+
+	v := url.Values{}
+	if err := want.EncodeValues("starts_at", &v); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v.Get("starts_at") != "2023-12-31T23:59:59-05:00" {
+		t.Fatalf("Expected query value 2023-12-31T23:59:59-05:00 but got %s", v.Get("starts_at"))
+	}
+}
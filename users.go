@@ -19,6 +19,7 @@ package gitlab
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"time"
@@ -300,6 +301,29 @@ func (s *UsersService) DeleteUser(user int, options ...RequestOptionFunc) (*Resp
 	return s.client.Do(req, nil)
 }
 
+// DeleteUserIdentity deletes the identity of a user for the given provider,
+// unlinking the user from that authentication provider. Available only for
+// admin. GitLab does not return the user when removing an identity, so the
+// updated user is retrieved with a follow-up request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#delete-authentication-identity-from-user
+func (s *UsersService) DeleteUserIdentity(user int, provider string, options ...RequestOptionFunc) (*User, *Response, error) {
+	u := fmt.Sprintf("users/%d/identities/%s", user, PathEscape(provider))
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.GetUser(user, GetUsersOptions{}, options...)
+}
+
 // CurrentUser gets currently authenticated user.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/users.html#list-current-user
@@ -323,10 +347,11 @@ func (s *UsersService) CurrentUser(options ...RequestOptionFunc) (*User, *Respon
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/users.html#user-status
 type UserStatus struct {
-	Emoji        string            `json:"emoji"`
-	Availability AvailabilityValue `json:"availability"`
-	Message      string            `json:"message"`
-	MessageHTML  string            `json:"message_html"`
+	Emoji         string            `json:"emoji"`
+	Availability  AvailabilityValue `json:"availability"`
+	Message       string            `json:"message"`
+	MessageHTML   string            `json:"message_html"`
+	ClearStatusAt *time.Time        `json:"clear_status_at"`
 }
 
 // CurrentUserStatus retrieves the user status
@@ -374,9 +399,10 @@ func (s *UsersService) GetUserStatus(user int, options ...RequestOptionFunc) (*U
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/users.html#set-user-status
 type UserStatusOptions struct {
-	Emoji        *string            `url:"emoji,omitempty" json:"emoji,omitempty"`
-	Availability *AvailabilityValue `url:"availability,omitempty" json:"availability,omitempty"`
-	Message      *string            `url:"message,omitempty" json:"message,omitempty"`
+	Emoji            *string                `url:"emoji,omitempty" json:"emoji,omitempty"`
+	Availability     *AvailabilityValue     `url:"availability,omitempty" json:"availability,omitempty"`
+	Message          *string                `url:"message,omitempty" json:"message,omitempty"`
+	ClearStatusAfter *ClearStatusAfterValue `url:"clear_status_after,omitempty" json:"clear_status_after,omitempty"`
 }
 
 // SetUserStatus sets the user's status
@@ -398,6 +424,102 @@ func (s *UsersService) SetUserStatus(opt *UserStatusOptions, options ...RequestO
 	return status, resp, nil
 }
 
+// UserPreferences represents the preferences of the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-preferences
+type UserPreferences struct {
+	ID                        int  `json:"id"`
+	UserID                    int  `json:"user_id"`
+	ViewDiffsFileByFile       bool `json:"view_diffs_file_by_file"`
+	ShowWhitespaceInDiffs     bool `json:"show_whitespace_in_diffs"`
+	PassUserIdentitiesToCIJwt bool `json:"pass_user_identities_to_ci_jwt"`
+}
+
+// GetUserPreferences retrieves the preferences of the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-preferences
+func (s *UsersService) GetUserPreferences(options ...RequestOptionFunc) (*UserPreferences, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "user/preferences", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	up := new(UserPreferences)
+	resp, err := s.client.Do(req, up)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return up, resp, nil
+}
+
+// UpdateUserPreferencesOptions represents the available
+// UpdateUserPreferences() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-preference-modification
+type UpdateUserPreferencesOptions struct {
+	ViewDiffsFileByFile       *bool `url:"view_diffs_file_by_file,omitempty" json:"view_diffs_file_by_file,omitempty"`
+	ShowWhitespaceInDiffs     *bool `url:"show_whitespace_in_diffs,omitempty" json:"show_whitespace_in_diffs,omitempty"`
+	PassUserIdentitiesToCIJwt *bool `url:"pass_user_identities_to_ci_jwt,omitempty" json:"pass_user_identities_to_ci_jwt,omitempty"`
+}
+
+// UpdateUserPreferences updates the preferences of the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#user-preference-modification
+func (s *UsersService) UpdateUserPreferences(opt *UpdateUserPreferencesOptions, options ...RequestOptionFunc) (*UserPreferences, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPut, "user/preferences", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	up := new(UserPreferences)
+	resp, err := s.client.Do(req, up)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return up, resp, nil
+}
+
+// UserAvatar represents the avatar of the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#upload-a-current-user-avatar
+type UserAvatar struct {
+	AvatarURL string `json:"avatar_url"`
+}
+
+// SetUserAvatar uploads a new avatar for the current user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#upload-a-current-user-avatar
+func (s *UsersService) SetUserAvatar(avatar io.Reader, filename string, options ...RequestOptionFunc) (*UserAvatar, *Response, error) {
+	req, err := s.client.UploadRequest(
+		http.MethodPut,
+		"user/avatar",
+		avatar,
+		filename,
+		UploadAvatar,
+		nil,
+		options,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(UserAvatar)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
 // UserAssociationsCount represents the user associations count.
 //
 // Gitlab API docs: https://docs.gitlab.com/ee/api/users.html#list-associations-count-for-user
@@ -1408,3 +1530,104 @@ func (s *UsersService) DisableTwoFactor(user int, options ...RequestOptionFunc)
 		return fmt.Errorf("Received unexpected result code: %d", resp.StatusCode)
 	}
 }
+
+// DisableUserAccessReport describes the actions taken by DisableUserAccess.
+type DisableUserAccessReport struct {
+	Blocked                     bool
+	RevokedImpersonationTokens  []int
+	RevokedPersonalAccessTokens []int
+	RemovedFromGroups           []int
+}
+
+// DisableUserAccess locks a user out of GitLab in a single call. It blocks
+// the user, revokes all of their active impersonation and personal access
+// tokens and removes them from every group they are a direct member of.
+// Available only for admin.
+//
+// The returned report lists the actions that succeeded, also when an error
+// stops the lockdown half way.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/users.html#block-user
+func (s *UsersService) DisableUserAccess(user int, options ...RequestOptionFunc) (*DisableUserAccessReport, error) {
+	report := new(DisableUserAccessReport)
+
+	if err := s.BlockUser(user, options...); err != nil {
+		return report, err
+	}
+	report.Blocked = true
+
+	itOpt := &GetAllImpersonationTokensOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		State:       String("active"),
+	}
+	var its []*ImpersonationToken
+	for {
+		page, resp, err := s.GetAllImpersonationTokens(user, itOpt, options...)
+		if err != nil {
+			return report, err
+		}
+		its = append(its, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		itOpt.Page = resp.NextPage
+	}
+	for _, it := range its {
+		if _, err := s.RevokeImpersonationToken(user, it.ID, options...); err != nil {
+			return report, err
+		}
+		report.RevokedImpersonationTokens = append(report.RevokedImpersonationTokens, it.ID)
+	}
+
+	patOpt := &ListPersonalAccessTokensOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		UserID:      Int(user),
+	}
+	var pats []*PersonalAccessToken
+	for {
+		page, resp, err := s.client.PersonalAccessTokens.ListPersonalAccessTokens(patOpt, options...)
+		if err != nil {
+			return report, err
+		}
+		pats = append(pats, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		patOpt.Page = resp.NextPage
+	}
+	for _, pat := range pats {
+		if !pat.Active {
+			continue
+		}
+		if _, err := s.client.PersonalAccessTokens.RevokePersonalAccessToken(pat.ID, options...); err != nil {
+			return report, err
+		}
+		report.RevokedPersonalAccessTokens = append(report.RevokedPersonalAccessTokens, pat.ID)
+	}
+
+	mOpt := &GetUserMembershipOptions{
+		ListOptions: ListOptions{PerPage: 100},
+		Type:        String("Namespace"),
+	}
+	var memberships []*UserMembership
+	for {
+		page, resp, err := s.GetUserMemberships(user, mOpt, options...)
+		if err != nil {
+			return report, err
+		}
+		memberships = append(memberships, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		mOpt.Page = resp.NextPage
+	}
+	for _, m := range memberships {
+		if _, err := s.client.GroupMembers.RemoveGroupMember(m.SourceID, user, nil, options...); err != nil {
+			return report, err
+		}
+		report.RemovedFromGroups = append(report.RemovedFromGroups, m.SourceID)
+	}
+
+	return report, nil
+}
@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net"
@@ -653,3 +654,223 @@ func TestDisableUser2FA(t *testing.T) {
 		t.Errorf("Users.DisableTwoFactor returned error: %v", err)
 	}
 }
+
+func TestCurrentUserStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"emoji":"coffee","availability":"busy","message":"I crave coffee","message_html":"I crave coffee","clear_status_at":"2023-01-02T15:04:05Z"}`)
+	})
+
+	clearAt := time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC)
+	want := &UserStatus{
+		Emoji:         "coffee",
+		Availability:  Busy,
+		Message:       "I crave coffee",
+		MessageHTML:   "I crave coffee",
+		ClearStatusAt: &clearAt,
+	}
+
+	status, _, err := client.Users.CurrentUserStatus()
+	require.NoError(t, err)
+	require.Equal(t, want, status)
+}
+
+func TestGetUserStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/users/1/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"emoji":"coffee","availability":"not_set","message":"I crave coffee"}`)
+	})
+
+	want := &UserStatus{Emoji: "coffee", Availability: NotSet, Message: "I crave coffee"}
+
+	status, _, err := client.Users.GetUserStatus(1)
+	require.NoError(t, err)
+	require.Equal(t, want, status)
+}
+
+func TestSetUserStatus(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"emoji":"calendar","availability":"busy","message":"In a meeting","clear_status_after":"3_hours"}`)
+		fmt.Fprint(w, `{"emoji":"calendar","availability":"busy","message":"In a meeting"}`)
+	})
+
+	opt := &UserStatusOptions{
+		Emoji:            String("calendar"),
+		Availability:     Availability(Busy),
+		Message:          String("In a meeting"),
+		ClearStatusAfter: ClearStatusAfter(ClearStatusAfter3Hours),
+	}
+
+	status, _, err := client.Users.SetUserStatus(opt)
+	require.NoError(t, err)
+	require.Equal(t, &UserStatus{Emoji: "calendar", Availability: Busy, Message: "In a meeting"}, status)
+}
+
+func TestGetUserPreferences(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/preferences", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":1,"user_id":1,"view_diffs_file_by_file":true,"show_whitespace_in_diffs":false}`)
+	})
+
+	want := &UserPreferences{ID: 1, UserID: 1, ViewDiffsFileByFile: true}
+
+	up, resp, err := client.Users.GetUserPreferences()
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, up)
+
+	up, resp, err = client.Users.GetUserPreferences(errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, up)
+}
+
+func TestUpdateUserPreferences(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/preferences", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testBody(t, r, `{"view_diffs_file_by_file":true,"show_whitespace_in_diffs":true}`)
+		fmt.Fprint(w, `{"id":1,"user_id":1,"view_diffs_file_by_file":true,"show_whitespace_in_diffs":true}`)
+	})
+
+	opt := &UpdateUserPreferencesOptions{
+		ViewDiffsFileByFile:   Bool(true),
+		ShowWhitespaceInDiffs: Bool(true),
+	}
+
+	want := &UserPreferences{ID: 1, UserID: 1, ViewDiffsFileByFile: true, ShowWhitespaceInDiffs: true}
+
+	up, resp, err := client.Users.UpdateUserPreferences(opt)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, want, up)
+}
+
+func TestSetUserAvatar(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/user/avatar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		require.Contains(t, r.Header.Get("Content-Type"), "multipart/form-data;")
+		require.NotEqual(t, int64(-1), r.ContentLength)
+		fmt.Fprint(w, `{"avatar_url":"http://gitlab.example.com/uploads/-/system/user/avatar/1/avatar.png"}`)
+	})
+
+	avatar := bytes.NewBufferString("avatar")
+	a, resp, err := client.Users.SetUserAvatar(avatar, "avatar.png")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, &UserAvatar{AvatarURL: "http://gitlab.example.com/uploads/-/system/user/avatar/1/avatar.png"}, a)
+
+	a, resp, err = client.Users.SetUserAvatar(avatar, "avatar.png", errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, a)
+}
+
+func TestDisableUserAccess(t *testing.T) {
+	mux, client := setup(t)
+
+	var revoked []string
+
+	mux.HandleFunc("/api/v4/users/1/block", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/api/v4/users/1/impersonation_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&state=active")
+		fmt.Fprint(w, `[{"id": 2, "active": true}]`)
+	})
+	mux.HandleFunc("/api/v4/users/1/impersonation_tokens/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		revoked = append(revoked, "impersonation/2")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&user_id=1")
+		fmt.Fprint(w, `[{"id": 2, "active": false, "revoked": true}, {"id": 3, "active": true}]`)
+	})
+	mux.HandleFunc("/api/v4/personal_access_tokens/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		revoked = append(revoked, "personal/3")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/users/1/memberships", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testParams(t, r, "per_page=100&type=Namespace")
+		fmt.Fprint(w, `[{"source_id": 4, "source_type": "Namespace"}]`)
+	})
+	mux.HandleFunc("/api/v4/groups/4/members/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	report, err := client.Users.DisableUserAccess(1)
+	require.NoError(t, err)
+
+	want := &DisableUserAccessReport{
+		Blocked:                     true,
+		RevokedImpersonationTokens:  []int{2},
+		RevokedPersonalAccessTokens: []int{3},
+		RemovedFromGroups:           []int{4},
+	}
+	assert.Equal(t, want, report)
+	assert.Equal(t, []string{"impersonation/2", "personal/3"}, revoked)
+}
+
+func TestDisableUserAccessBlockPrevented(t *testing.T) {
+	mux, client := setup(t)
+
+	mux.HandleFunc("/api/v4/users/1/block", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	report, err := client.Users.DisableUserAccess(1)
+	require.ErrorIs(t, err, ErrUserBlockPrevented)
+	assert.Equal(t, &DisableUserAccessReport{}, report)
+}
+
+func TestDeleteUserIdentity(t *testing.T) {
+	mux, client := setup(t)
+
+	deleted := false
+
+	mux.HandleFunc("/api/v4/users/1/identities/saml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "username": "john_smith", "identities": [{"provider": "github", "extern_uid": "2435223452345"}]}`)
+	})
+
+	user, resp, err := client.Users.DeleteUserIdentity(1, "saml")
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.True(t, deleted)
+	assert.Equal(t, []*UserIdentity{{Provider: "github", ExternUID: "2435223452345"}}, user.Identities)
+
+	user, resp, err = client.Users.DeleteUserIdentity(1, "saml", errorOption)
+	require.EqualError(t, err, "RequestOptionFunc returns an error")
+	require.Nil(t, resp)
+	require.Nil(t, user)
+
+	user, resp, err = client.Users.DeleteUserIdentity(2, "saml")
+	require.Error(t, err)
+	require.Nil(t, user)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}